@@ -0,0 +1,123 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/AlexanderGhosty/log-linter/pkg/analyzer"
+	"github.com/AlexanderGhosty/log-linter/pkg/baseline"
+	"github.com/AlexanderGhosty/log-linter/pkg/config"
+	"github.com/AlexanderGhosty/log-linter/pkg/output"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/checker"
+	"golang.org/x/tools/go/packages"
+)
+
+// runChecker analyzes the packages named in args and either prints the
+// diagnostics in the requested format or writes them to a baseline file.
+func runChecker(cfg *config.Config, args []string) int {
+	fs := flag.NewFlagSet("loglinter", flag.ExitOnError)
+	format := fs.String("format", output.FormatText,
+		"output format: "+strings.Join(output.Formats, ", "))
+	writeBaseline := fs.String("write-baseline", "",
+		"record the current diagnostics in the given baseline `file` instead of reporting them")
+	tests := fs.Bool("test", true, "also analyze test packages")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: loglinter [-format=FORMAT | -write-baseline=FILE] [-config=FILE] [-test=false] package...\n")
+		fs.PrintDefaults()
+	}
+	_ = fs.Parse(args) // exits on error
+
+	if !slices.Contains(output.Formats, *format) {
+		fmt.Fprintf(os.Stderr, "loglinter: unknown output format %q (must be one of %s)\n",
+			*format, strings.Join(output.Formats, ", "))
+		return exitError
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return exitError
+	}
+
+	if *writeBaseline != "" {
+		// Record every finding, including those of an existing baseline.
+		noBaseline := *cfg
+		noBaseline.Baseline = ""
+		cfg = &noBaseline
+	}
+
+	pkgs, err := packages.Load(&packages.Config{
+		Mode:  packages.LoadAllSyntax,
+		Tests: *tests,
+	}, fs.Args()...)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "loglinter: %v\n", err)
+		return exitError
+	}
+	if packages.PrintErrors(pkgs) > 0 {
+		return exitError
+	}
+
+	graph, err := checker.Analyze([]*analysis.Analyzer{analyzer.New(cfg)}, pkgs, nil)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "loglinter: %v\n", err)
+		return exitError
+	}
+
+	dir, _ := os.Getwd()
+	var diags []output.Diagnostic
+	var b *baseline.Baseline
+	if *writeBaseline != "" {
+		b = baseline.New()
+	}
+	seen := make(map[string]bool)
+	failed := false
+	for _, act := range graph.Roots {
+		if act.Err != nil {
+			fmt.Fprintf(os.Stderr, "loglinter: %s: %v\n", act.Package.PkgPath, act.Err)
+			failed = true
+			continue
+		}
+		for _, d := range act.Diagnostics {
+			od := output.FromAnalysis(act.Package.Fset, d, cfg, dir)
+			// Test variants of a package report the same diagnostics again.
+			key := fmt.Sprintf("%s:%d:%d:%s:%s", od.File, od.Line, od.Column, od.Rule, od.Message)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			diags = append(diags, od)
+
+			if b != nil {
+				source := baseline.Source(act.Package.Fset, d.Pos, d.End, nil)
+				filename := act.Package.Fset.Position(d.Pos).Filename
+				b.Add(filename, od.Rule, baseline.Fingerprint(od.Rule, od.Message, source))
+			}
+		}
+	}
+
+	if failed {
+		return exitError
+	}
+
+	if b != nil {
+		if err := b.Write(*writeBaseline); err != nil {
+			fmt.Fprintf(os.Stderr, "loglinter: %v\n", err)
+			return exitError
+		}
+		fmt.Fprintf(os.Stderr, "loglinter: recorded %d findings in %s\n", b.Len(), *writeBaseline)
+		return 0
+	}
+
+	output.Sort(diags)
+	if err := output.Write(os.Stdout, *format, diags, analyzer.RuleNames()); err != nil {
+		fmt.Fprintf(os.Stderr, "loglinter: %v\n", err)
+		return exitError
+	}
+	if len(diags) > 0 {
+		return exitDiagnostics
+	}
+	return 0
+}
@@ -1,11 +1,113 @@
 // Package main is the entry point for the loglinter executable.
+//
+// Without a -format or -write-baseline flag, loglinter behaves like any
+// go/analysis single checker (see singlechecker). With
+// -format=text|json|sarif|checkstyle it loads the packages itself and writes
+// all diagnostics in that format; with -write-baseline=FILE it records the
+// current diagnostics in a baseline file instead (see package baseline).
+//
+// The configuration is read from the file given by -config or, if the flag
+// is absent, from the first .loglinter.{yml,yaml,json,toml} found in the
+// working directory or one of its parents.
 package main
 
 import (
+	"fmt"
+	"os"
+	"strings"
+
 	"github.com/AlexanderGhosty/log-linter/pkg/analyzer"
+	"github.com/AlexanderGhosty/log-linter/pkg/config"
 	"golang.org/x/tools/go/analysis/singlechecker"
 )
 
+// Exit codes, matching singlechecker.
+const (
+	exitError       = 1
+	exitDiagnostics = 3
+)
+
 func main() {
-	singlechecker.Main(analyzer.New(nil))
+	configPath, args := extractConfigFlag(os.Args[1:])
+	cfg, err := loadConfig(configPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "loglinter: %v\n", err)
+		os.Exit(exitError)
+	}
+
+	if !hasFlag(args, "format") && !hasFlag(args, "write-baseline") {
+		// singlechecker parses the remaining flags itself.
+		os.Args = append(os.Args[:1], args...)
+		singlechecker.Main(analyzer.New(cfg))
+		return
+	}
+
+	os.Exit(runChecker(cfg, args))
+}
+
+// extractConfigFlag removes the -config flag and its value from args. It is
+// handled here rather than through a flag set so that it also works together
+// with the flags of singlechecker.
+func extractConfigFlag(args []string) (string, []string) {
+	path := ""
+	rest := make([]string, 0, len(args))
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			rest = append(rest, args[i:]...)
+			break
+		}
+		switch name := strings.TrimLeft(arg, "-"); {
+		case !strings.HasPrefix(arg, "-"):
+			rest = append(rest, arg)
+		case name == "config" && i+1 < len(args):
+			path = args[i+1]
+			i++
+		case strings.HasPrefix(name, "config="):
+			path = strings.TrimPrefix(name, "config=")
+		default:
+			rest = append(rest, arg)
+		}
+	}
+	return path, rest
+}
+
+// loadConfig loads the config file at path, or searches for one upwards from
+// the working directory if path is empty. Without a config file the defaults
+// are used.
+func loadConfig(path string) (*config.Config, error) {
+	if path == "" {
+		dir, err := os.Getwd()
+		if err != nil {
+			return nil, err
+		}
+		if path, err = config.Find(dir); err != nil {
+			return nil, err
+		}
+		if path == "" {
+			return &config.Config{}, nil
+		}
+	}
+	cfg, err := config.Load(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := cfg.ValidateRuleNames(analyzer.RuleNames()); err != nil {
+		return nil, fmt.Errorf("config %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// hasFlag reports whether the named flag appears among the leading flags of args.
+func hasFlag(args []string, flagName string) bool {
+	for _, arg := range args {
+		if arg == "--" || !strings.HasPrefix(arg, "-") {
+			return false
+		}
+		name := strings.TrimLeft(arg, "-")
+		if name == flagName || strings.HasPrefix(name, flagName+"=") {
+			return true
+		}
+	}
+	return false
 }
@@ -3,10 +3,12 @@ module github.com/AlexanderGhosty/log-linter
 go 1.23.0
 
 require (
+	github.com/BurntSushi/toml v1.5.0
 	github.com/golangci/plugin-module-register v0.1.2
 	github.com/mitchellh/mapstructure v1.5.0
 	go.uber.org/zap v1.27.1
 	golang.org/x/tools v0.32.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -2,14 +2,19 @@
 package analyzer
 
 import (
+	"fmt"
 	"go/ast"
-	"go/constant"
 	"go/token"
+	"os"
+	"sync"
 
+	"github.com/AlexanderGhosty/log-linter/pkg/baseline"
 	"github.com/AlexanderGhosty/log-linter/pkg/config"
+	"github.com/AlexanderGhosty/log-linter/pkg/errflow"
 	"github.com/AlexanderGhosty/log-linter/pkg/logsupport"
 	"github.com/AlexanderGhosty/log-linter/pkg/rules"
-	"github.com/AlexanderGhosty/log-linter/pkg/utils"
+	"github.com/AlexanderGhosty/log-linter/pkg/spelling"
+	"github.com/AlexanderGhosty/log-linter/pkg/taint"
 	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/analysis/passes/inspect"
 	"golang.org/x/tools/go/ast/inspector"
@@ -22,26 +27,213 @@ func New(cfg *config.Config) *analysis.Analyzer {
 	}
 
 	registry := logsupport.NewRegistry(cfg.Loggers)
+	taintAnalyzer := newTaintAnalyzer(cfg)
+	flowAnalyzer := newFlowAnalyzer(cfg)
+	dictionary, dictErr := readDictionaries(cfg)
+	allRules := newRules(cfg, registry, taintAnalyzer, flowAnalyzer, dictionary)
 
-	registeredRules := []rules.Rule{
-		rules.NewLowercase(),
-		rules.NewEnglish(registry),
-		rules.NewSymbols(registry, cfg.Symbols.Allowed),
-		rules.NewSensitive(registry, cfg.Sensitive.Keywords, cfg.Sensitive.Patterns),
+	knownRules := make(map[string]bool, len(allRules))
+	var registeredRules []rules.Rule
+	for _, rule := range allRules {
+		knownRules[rule.Name()] = true
+		if ruleEnabled(cfg, rule.Name()) {
+			registeredRules = append(registeredRules, rule)
+		}
+	}
+
+	loadBaseline := sync.OnceValues(func() (*baseline.Baseline, error) {
+		if cfg.Baseline == "" {
+			return nil, nil
+		}
+		return baseline.Load(cfg.Baseline)
+	})
+
+	requires := []*analysis.Analyzer{inspect.Analyzer}
+	if knownRules[taintCategory] && cfg.IsRuleEnabled(taintCategory, true) {
+		requires = append(requires, taintAnalyzer)
+	}
+	if ruleEnabled(cfg, logReturnCategory) {
+		requires = append(requires, flowAnalyzer)
 	}
 
 	return &analysis.Analyzer{
 		Name: "loglinter",
 		Doc:  "checks log messages for common style issues",
 		Run: func(pass *analysis.Pass) (interface{}, error) {
-			return run(pass, registry, registeredRules)
+			if dictErr != nil {
+				return nil, dictErr
+			}
+			b, err := loadBaseline()
+			if err != nil {
+				return nil, err
+			}
+			return run(pass, cfg, registry, b, knownRules, registeredRules)
 		},
-		Requires: []*analysis.Analyzer{inspect.Analyzer},
+		Requires:  requires,
+		FactTypes: []analysis.Fact{new(logsupport.WrapperFact)},
+	}
+}
+
+// RuleNames returns the names of all rules the analyzer can report, in a
+// stable order, ending with the directive and baseline categories. They are
+// the valid keys of the rules settings. Diagnostics carry the rule name in
+// their Category.
+func RuleNames() []string {
+	cfg := &config.Config{}
+	var names []string
+	for _, rule := range newRules(cfg, logsupport.NewRegistry(nil), newTaintAnalyzer(cfg), newFlowAnalyzer(cfg), nil) {
+		names = append(names, rule.Name())
+	}
+	return append(names, directiveCategory, baselineCategory)
+}
+
+// optionalRules are the rules that only run when enabled in the configuration.
+var optionalRules = map[string]bool{
+	spellingCategory:  true,
+	keyStyleCategory:  true,
+	templateCategory:  true,
+	errorCategory:     true,
+	logReturnCategory: true,
+}
+
+// ruleEnabled reports whether the named rule should run.
+func ruleEnabled(cfg *config.Config, name string) bool {
+	return cfg.IsRuleEnabled(name, !optionalRules[name])
+}
+
+// Names of the optional rules.
+const (
+	spellingCategory  = "spelling"
+	keyStyleCategory  = "key-style"
+	templateCategory  = "message-template"
+	errorCategory     = "error-attribute"
+	logReturnCategory = "log-and-return"
+)
+
+// readDictionaries reads the words of the spelling dictionaries, if the
+// spelling rule is enabled.
+func readDictionaries(cfg *config.Config) ([]string, error) {
+	if !ruleEnabled(cfg, spellingCategory) {
+		return nil, nil
+	}
+	var words []string
+	for _, path := range cfg.Spelling.Dictionaries {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("spelling dictionary: %w", err)
+		}
+		dictWords, err := spelling.ParseWords(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("spelling dictionary %s: %w", path, err)
+		}
+		words = append(words, dictWords...)
+	}
+	return words, nil
+}
+
+// taintCategory is the name of the rule that reads the taint analyzer's results.
+const taintCategory = "taint"
+
+// newTaintAnalyzer returns the analyzer that tracks sensitive values for the
+// taint rule. It only runs if the rule is enabled.
+func newTaintAnalyzer(cfg *config.Config) *analysis.Analyzer {
+	return taint.New(rules.SensitiveKeywords(cfg.Sensitive.Keywords), cfg.Sensitive.TaintSources)
+}
+
+// newFlowAnalyzer returns the analyzer that follows errors for the
+// log-and-return rule. It only runs if the rule is enabled.
+func newFlowAnalyzer(cfg *config.Config) *analysis.Analyzer {
+	return errflow.New(cfg.LogAndReturn.ExemptFunctions, cfg.LogAndReturn.ExemptPackages)
+}
+
+func newRules(cfg *config.Config, registry *logsupport.Registry, taintAnalyzer, flowAnalyzer *analysis.Analyzer, dictionary []string) []rules.Rule {
+	return []rules.Rule{
+		rules.NewLowercase(),
+		rules.NewEnglish(registry, englishOptions(cfg.English.EnglishSettings), englishOverrides(cfg.English.Overrides)),
+		rules.NewSymbols(registry, cfg.Symbols.Allowed),
+		rules.NewSensitive(registry, cfg.Sensitive.Keywords, cfg.Sensitive.Patterns, cfg.Sensitive.Types, cfg.Sensitive.Redact),
+		rules.NewTaint(registry, taintAnalyzer, cfg.Sensitive.Keywords, cfg.Sensitive.Patterns, cfg.Sensitive.Redact),
+		rules.NewSecrets(registry, cfg.Secrets.MinEntropy, cfg.Secrets.MinLength),
+		rules.NewPrintf(registry),
+		rules.NewKeyValue(registry),
+		rules.NewSpelling(registry, append(cfg.Spelling.Words, dictionary...)),
+		rules.NewKeyStyle(registry, cfg.KeyStyle.Style, cfg.KeyStyle.Pattern),
+		rules.NewTemplate(registry, cfg.KeyStyle.Style),
+		rules.NewErrorAttr(registry),
+		rules.NewLogReturn(registry, flowAnalyzer),
 	}
 }
 
-func run(pass *analysis.Pass, registry *logsupport.Registry, registeredRules []rules.Rule) (interface{}, error) {
+func englishOptions(s config.EnglishSettings) rules.EnglishOptions {
+	return rules.EnglishOptions{
+		Language: s.Language,
+		Scripts:  s.Scripts,
+		Ranges:   s.Ranges,
+		Words:    s.Words,
+	}
+}
+
+func englishOverrides(overrides []config.EnglishOverride) []rules.EnglishOverride {
+	var out []rules.EnglishOverride
+	for _, o := range overrides {
+		out = append(out, rules.EnglishOverride{
+			Packages: o.Packages,
+			Options:  englishOptions(o.EnglishSettings),
+		})
+	}
+	return out
+}
+
+func run(
+	pass *analysis.Pass,
+	cfg *config.Config,
+	registry *logsupport.Registry,
+	b *baseline.Baseline,
+	knownRules map[string]bool,
+	registeredRules []rules.Rule,
+) (interface{}, error) {
+	exportWrapperFacts(pass, registry)
+
+	passRules := make([]rules.Rule, len(registeredRules))
+	for i, rule := range registeredRules {
+		if pkgRule, ok := rule.(rules.PackageRule); ok {
+			rule = pkgRule.ForPackage(pass.Pkg.Path())
+		}
+		passRules[i] = rule
+	}
+
+	directives, malformed := parseDirectives(pass, knownRules)
+	filter := newBaselineFilter(pass, b)
+
+	// reported holds the source ranges each rule reported so far: a rule
+	// reports once per range, e.g. on a message variable that is checked both
+	// for its name and for the fragment it is defined as.
+	type reportKey struct {
+		rule     string
+		pos, end token.Pos
+	}
+	reported := make(map[reportKey]bool)
+
+	reportRule := func(name string, d analysis.Diagnostic) {
+		key := reportKey{rule: name, pos: d.Pos, end: d.End}
+		if reported[key] {
+			return
+		}
+		reported[key] = true
+		for _, dir := range directives {
+			if dir.suppresses(name, d.Pos) {
+				return
+			}
+		}
+		if filter.suppresses(name, d) {
+			return
+		}
+		report(pass, cfg, name, d)
+	}
+
 	inspectAnalyzer := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	extractor := newMessageExtractor(pass, inspectAnalyzer)
 
 	nodeFilter := []ast.Node{
 		(*ast.CallExpr)(nil),
@@ -50,63 +242,101 @@ func run(pass *analysis.Pass, registry *logsupport.Registry, registeredRules []r
 	inspectAnalyzer.Preorder(nodeFilter, func(n ast.Node) {
 		call := n.(*ast.CallExpr)
 
-		pkgPath, funcName, ok := utils.ResolveCallPackagePath(pass, call)
+		lc, ok := registry.Resolve(pass, call)
 		if !ok {
 			return
 		}
 
-		if !registry.IsSupportedLogger(pkgPath, funcName) {
-			return
+		var parts []messagePart
+		if lc.MessageIndex < len(call.Args) {
+			parts = extractor.extract(call.Args[lc.MessageIndex], 0)
 		}
+		complete := len(parts) == 1 && parts[0].known
 
-		msgArgIndex := registry.MessageIndex(pkgPath, funcName)
-		msg, pos, end, found := extractLogMessage(pass, call, msgArgIndex)
-
-		for _, rule := range registeredRules {
-			// Basic string rules
-			if found {
-				if diags := rule.Check(msg, pos, end); len(diags) > 0 {
-					for _, d := range diags {
-						pass.Report(d)
-					}
+		for _, rule := range passRules {
+			// Basic string rules, run on the constant parts of the message
+			for i, part := range parts {
+				if !part.known {
+					continue
+				}
+				for _, d := range checkPart(rule, part, i == 0, complete, lc.Printf) {
+					reportRule(rule.Name(), atUse(d, part))
 				}
 			}
 
 			// Advanced expression rules (check even if string literal wasn't found,
 			// e.g. for analyzing context or other args)
 			if exprRule, ok := rule.(rules.ExprRule); ok {
-				if diags := exprRule.CheckCall(call, pass); len(diags) > 0 {
-					for _, d := range diags {
-						pass.Report(d)
-					}
+				for _, d := range exprRule.CheckCall(call, pass) {
+					reportRule(rule.Name(), d)
 				}
 			}
 		}
 	})
 
-	return nil, nil
-}
+	enabled := make(map[string]bool, len(registeredRules)+1)
+	for _, rule := range registeredRules {
+		enabled[rule.Name()] = true
+	}
 
-func extractLogMessage(pass *analysis.Pass, call *ast.CallExpr, argIndex int) (string, token.Pos, token.Pos, bool) {
-	if len(call.Args) == 0 {
-		return "", token.NoPos, token.NoPos, false
+	if cfg.IsRuleEnabled(directiveCategory, true) {
+		for _, d := range append(malformed, unusedDirectives(directives, enabled)...) {
+			if !filter.suppresses(directiveCategory, d) {
+				report(pass, cfg, directiveCategory, d)
+			}
+		}
 	}
 
-	if len(call.Args) <= argIndex {
-		return "", token.NoPos, token.NoPos, false
+	if cfg.IsRuleEnabled(baselineCategory, true) {
+		enabled[directiveCategory] = cfg.IsRuleEnabled(directiveCategory, true)
+		for _, d := range filter.stale(enabled) {
+			report(pass, cfg, baselineCategory, d)
+		}
 	}
 
-	targetArg := call.Args[argIndex]
+	return nil, nil
+}
 
-	tv, ok := pass.TypesInfo.Types[targetArg]
-	if !ok || tv.Value == nil {
-		return "", token.NoPos, token.NoPos, false
+// report tags the diagnostic with the rule name and its configured severity
+// before handing it to the pass. Severities other than "error" are prefixed to
+// the message so they remain visible in plain-text output and can be matched by
+// golangci-lint severity rules.
+func report(pass *analysis.Pass, cfg *config.Config, name string, d analysis.Diagnostic) {
+	d.Category = name
+	if severity := cfg.RuleSeverity(name); severity != config.SeverityError {
+		d.Message = severity + ": " + d.Message
 	}
+	pass.Report(d)
+}
 
-	if tv.Value.Kind() != constant.String {
-		return "", token.NoPos, token.NoPos, false
+// atUse moves a diagnostic on a message part defined in a variable to where
+// the log call uses the variable, so that it is reported, and can be
+// suppressed, for each call. The definition becomes related information;
+// suggested fixes still edit it.
+func atUse(d analysis.Diagnostic, part messagePart) analysis.Diagnostic {
+	if part.use == nil {
+		return d
 	}
+	d.Related = append([]analysis.RelatedInformation{{
+		Pos:     d.Pos,
+		End:     d.End,
+		Message: "message fragment defined here",
+	}}, d.Related...)
+	d.Pos, d.End = part.use.Pos(), part.use.End()
+	return d
+}
 
-	msg := constant.StringVal(tv.Value)
-	return msg, targetArg.Pos(), targetArg.End(), true
+// checkPart runs a rule on a constant part of a log message. A message that
+// is a single constant is checked as a whole; otherwise rules implementing
+// rules.FragmentRule check each part as a fragment, start telling whether
+// the message starts with it.
+func checkPart(rule rules.Rule, part messagePart, start, complete, printf bool) []analysis.Diagnostic {
+	msg := rules.NewMessage(part.text, part.expr)
+	if fragmentRule, ok := rule.(rules.FragmentRule); ok && !complete {
+		return fragmentRule.CheckFragment(msg, start)
+	}
+	if formatRule, ok := rule.(rules.FormatRule); ok && (printf || part.format) {
+		return formatRule.CheckFormat(msg)
+	}
+	return rule.Check(msg)
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/AlexanderGhosty/log-linter/pkg/analyzer"
+	"github.com/AlexanderGhosty/log-linter/pkg/baseline"
 	"github.com/AlexanderGhosty/log-linter/pkg/config"
 	"golang.org/x/tools/go/analysis/analysistest"
 
@@ -56,3 +57,327 @@ func TestAnalyzer_CustomLogger(t *testing.T) {
 
 	analysistest.Run(t, testdata, analyzer.New(cfg), "custom")
 }
+
+func TestAnalyzer_CustomZapLogger(t *testing.T) {
+	testdata, err := filepath.Abs("../../testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := &config.Config{
+		Loggers: []config.LoggerConfig{{
+			Package:           "customzap",
+			UserType:          "zap",
+			FieldConstructors: []string{"String", "Error"},
+		}},
+	}
+
+	analysistest.Run(t, testdata, analyzer.New(cfg), "customzap/app")
+}
+
+func TestAnalyzer_Rules(t *testing.T) {
+	testdata, err := filepath.Abs("../../testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	disabled := false
+	cfg := &config.Config{
+		Rules: map[string]config.RuleConfig{
+			"english":   {Enabled: &disabled},
+			"lowercase": {Severity: config.SeverityWarning},
+		},
+	}
+
+	analysistest.Run(t, testdata, analyzer.New(cfg), "rulescheck")
+}
+
+func TestAnalyzer_IgnoreDirectives(t *testing.T) {
+	testdata, err := filepath.Abs("../../testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	analysistest.Run(t, testdata, analyzer.New(nil), "ignorecheck")
+}
+
+func TestAnalyzer_Printf(t *testing.T) {
+	testdata, err := filepath.Abs("../../testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	analysistest.Run(t, testdata, analyzer.New(nil), "printfcheck")
+}
+
+func TestAnalyzer_StdLog(t *testing.T) {
+	testdata, err := filepath.Abs("../../testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	analysistest.Run(t, testdata, analyzer.New(nil), "stdcheck")
+}
+
+func TestAnalyzer_Zerolog(t *testing.T) {
+	testdata, err := filepath.Abs("../../testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	analysistest.Run(t, testdata, analyzer.New(nil), "zerologcheck")
+}
+
+func TestAnalyzer_Logrus(t *testing.T) {
+	testdata, err := filepath.Abs("../../testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	analysistest.Run(t, testdata, analyzer.New(nil), "logruscheck")
+}
+
+func TestAnalyzer_Wrappers(t *testing.T) {
+	testdata, err := filepath.Abs("../../testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	analysistest.Run(t, testdata, analyzer.New(nil), "wrappercheck")
+}
+
+func TestAnalyzer_LoggerTypes(t *testing.T) {
+	testdata, err := filepath.Abs("../../testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := &config.Config{
+		Loggers: []config.LoggerConfig{{
+			Package:  "typecheck/logging",
+			UserType: "generic",
+			Types:    []string{"typecheck/logging.Printer"},
+		}},
+	}
+
+	analysistest.Run(t, testdata, analyzer.New(cfg), "typecheck")
+}
+
+func TestAnalyzer_WrapperFacts(t *testing.T) {
+	testdata, err := filepath.Abs("../../testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	analysistest.Run(t, testdata, analyzer.New(nil), "wrapfacts/logutil", "wrapfacts")
+}
+
+func TestAnalyzer_GenericMethods(t *testing.T) {
+	testdata, err := filepath.Abs("../../testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msgIndex, argsIndex := 1, 1
+	cfg := &config.Config{
+		Loggers: []config.LoggerConfig{{
+			Package:  "genericcheck",
+			UserType: "generic",
+			Methods: []config.MethodConfig{
+				{Name: "Info", Receiver: "Console", MessageIndex: &msgIndex},
+				{Name: "Info", Receiver: "Logger", ArgsIndex: &argsIndex},
+				{Name: "Log", Receiver: "Logger", MessageIndex: &msgIndex},
+				{Pattern: "[A-Z][a-z]*f", Receiver: "Logger", MessageIndex: &msgIndex, Printf: true},
+			},
+		}},
+	}
+
+	analysistest.Run(t, testdata, analyzer.New(cfg), "genericcheck")
+}
+
+func TestAnalyzer_Baseline(t *testing.T) {
+	testdata, err := filepath.Abs("../../testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Two of the three "Legacy message" findings are baselined, the symbols
+	// entry does not match the reported message and the english entry matches
+	// nothing, so both are stale.
+	file := filepath.Join(testdata, "src", "baselinecheck", "baselinecheck.go")
+	b := baseline.New()
+	lowercase := baseline.Fingerprint("lowercase", "log message should start with a lowercase letter", `"Legacy message"`)
+	b.Add(file, "lowercase", lowercase)
+	b.Add(file, "lowercase", lowercase)
+	b.Add(file, "symbols", baseline.Fingerprint("symbols", "log message should not contain special characters or emoji", `"legacy message"`))
+	b.Add(file, "english", baseline.Fingerprint("english", "log message should be in English", `"сообщение"`))
+
+	path := filepath.Join(t.TempDir(), "baseline.json")
+	if err := b.Write(path); err != nil {
+		t.Fatal(err)
+	}
+
+	analysistest.Run(t, testdata, analyzer.New(&config.Config{Baseline: path}), "baselinecheck")
+}
+
+func TestAnalyzer_Secrets(t *testing.T) {
+	testdata, err := filepath.Abs("../../testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	analysistest.Run(t, testdata, analyzer.New(nil), "secretscheck")
+}
+
+func TestAnalyzer_SensitiveTypes(t *testing.T) {
+	testdata, err := filepath.Abs("../../testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := &config.Config{
+		Sensitive: config.SensitiveConfig{Types: []string{"typedsensitive/auth.Token"}},
+	}
+	analysistest.Run(t, testdata, analyzer.New(cfg), "typedsensitive")
+}
+
+func TestAnalyzer_Taint(t *testing.T) {
+	testdata, err := filepath.Abs("../../testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	analysistest.Run(t, testdata, analyzer.New(nil), "taintcheck")
+}
+
+func TestAnalyzer_RedactFixes(t *testing.T) {
+	testdata, err := filepath.Abs("../../testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	analysistest.RunWithSuggestedFixes(t, testdata, analyzer.New(nil), "redactcheck")
+
+	cfg := &config.Config{
+		Sensitive: config.SensitiveConfig{Redact: "redact.String"},
+	}
+	analysistest.RunWithSuggestedFixes(t, testdata, analyzer.New(cfg), "redacthelper")
+}
+
+func TestAnalyzer_EnglishOverrides(t *testing.T) {
+	testdata, err := filepath.Abs("../../testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := &config.Config{
+		English: config.EnglishConfig{
+			EnglishSettings: config.EnglishSettings{Words: []string{"café"}},
+			Overrides: []config.EnglishOverride{{
+				Packages:        []string{"englishcheck/i18n/..."},
+				EnglishSettings: config.EnglishSettings{Language: "ru"},
+			}},
+		},
+	}
+	analysistest.Run(t, testdata, analyzer.New(cfg), "englishcheck", "englishcheck/i18n")
+}
+
+func TestAnalyzer_Spelling(t *testing.T) {
+	testdata, err := filepath.Abs("../../testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	enabled := true
+	cfg := &config.Config{
+		Rules: map[string]config.RuleConfig{
+			"spelling": {Enabled: &enabled},
+		},
+		Spelling: config.SpellingConfig{
+			Words:        []string{"Kubernetes"},
+			Dictionaries: []string{filepath.Join(testdata, "src", "spellcheck", "words.txt")},
+		},
+	}
+	analysistest.RunWithSuggestedFixes(t, testdata, analyzer.New(cfg), "spellcheck")
+}
+
+func TestAnalyzer_KeyStyle(t *testing.T) {
+	testdata, err := filepath.Abs("../../testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	enabled := true
+	rules := map[string]config.RuleConfig{"key-style": {Enabled: &enabled}}
+	analysistest.RunWithSuggestedFixes(t, testdata, analyzer.New(&config.Config{Rules: rules}), "keystylecheck")
+
+	cfg := &config.Config{
+		Rules:    rules,
+		KeyStyle: config.KeyStyleConfig{Style: "regex", Pattern: `^[a-z]+(\.[a-z]+)*$`},
+	}
+	analysistest.Run(t, testdata, analyzer.New(cfg), "keystyleregex")
+}
+
+func TestAnalyzer_KeyValue(t *testing.T) {
+	testdata, err := filepath.Abs("../../testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	analysistest.Run(t, testdata, analyzer.New(nil), "kvcheck")
+}
+
+func TestAnalyzer_MessageExtraction(t *testing.T) {
+	testdata, err := filepath.Abs("../../testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	analysistest.RunWithSuggestedFixes(t, testdata, analyzer.New(nil), "messagecheck")
+	analysistest.RunWithSuggestedFixes(t, testdata, analyzer.New(nil), "messagevarcheck")
+}
+
+func TestAnalyzer_LiteralRanges(t *testing.T) {
+	testdata, err := filepath.Abs("../../testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	analysistest.RunWithSuggestedFixes(t, testdata, analyzer.New(nil), "rangecheck")
+}
+
+func TestAnalyzer_MessageTemplate(t *testing.T) {
+	testdata, err := filepath.Abs("../../testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	enabled := true
+	rules := map[string]config.RuleConfig{"message-template": {Enabled: &enabled}}
+	analysistest.RunWithSuggestedFixes(t, testdata, analyzer.New(&config.Config{Rules: rules}), "templatecheck")
+}
+
+func TestAnalyzer_ErrorAttribute(t *testing.T) {
+	testdata, err := filepath.Abs("../../testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	enabled := true
+	rules := map[string]config.RuleConfig{"error-attribute": {Enabled: &enabled}}
+	analysistest.RunWithSuggestedFixes(t, testdata, analyzer.New(&config.Config{Rules: rules}), "errorcheck")
+}
+
+func TestAnalyzer_LogAndReturn(t *testing.T) {
+	testdata, err := filepath.Abs("../../testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	enabled := true
+	cfg := &config.Config{
+		Rules:        map[string]config.RuleConfig{"log-and-return": {Enabled: &enabled}},
+		LogAndReturn: config.LogAndReturnConfig{ExemptFunctions: []string{"logreturncheck.Handle"}},
+	}
+	analysistest.Run(t, testdata, analyzer.New(cfg), "logreturncheck")
+}
@@ -0,0 +1,82 @@
+package analyzer
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/AlexanderGhosty/log-linter/pkg/baseline"
+	"golang.org/x/tools/go/analysis"
+)
+
+// baselineCategory is the rule name used to report stale baseline entries.
+const baselineCategory = "baseline"
+
+// baselineFilter suppresses the findings recorded in a baseline for a single
+// pass.
+type baselineFilter struct {
+	pass     *analysis.Pass
+	matcher  *baseline.Matcher
+	contents map[string][]byte
+}
+
+func newBaselineFilter(pass *analysis.Pass, b *baseline.Baseline) *baselineFilter {
+	if b == nil {
+		return nil
+	}
+	return &baselineFilter{
+		pass:     pass,
+		matcher:  b.NewMatcher(),
+		contents: make(map[string][]byte),
+	}
+}
+
+// suppresses reports whether the diagnostic of the named rule is baselined.
+// The fingerprint is computed from the message before the severity prefix is
+// added, so changing a rule's severity does not invalidate the baseline.
+func (f *baselineFilter) suppresses(name string, d analysis.Diagnostic) bool {
+	if f == nil {
+		return false
+	}
+	source := baseline.Source(f.pass.Fset, d.Pos, d.End, f.readFile)
+	filename := f.pass.Fset.Position(d.Pos).Filename
+	return f.matcher.Suppress(filename, name, baseline.Fingerprint(name, d.Message, source))
+}
+
+// stale returns a diagnostic, at the package clause of each file, for every
+// baseline entry of an enabled rule that no longer matches a finding.
+func (f *baselineFilter) stale(enabled map[string]bool) []analysis.Diagnostic {
+	if f == nil {
+		return nil
+	}
+	var diags []analysis.Diagnostic
+	for _, file := range f.pass.Files {
+		filename := f.pass.Fset.Position(file.Package).Filename
+		for _, e := range f.matcher.Stale(filename) {
+			if !enabled[e.Rule] {
+				continue
+			}
+			diags = append(diags, analysis.Diagnostic{
+				Pos:     file.Package,
+				Message: fmt.Sprintf("baseline entry for rule %q no longer occurs (%d stale, fingerprint %s)", e.Rule, e.Count, e.Fingerprint),
+			})
+		}
+	}
+	return diags
+}
+
+// readFile reads and caches the files the diagnostics point into.
+func (f *baselineFilter) readFile(filename string) ([]byte, error) {
+	if content, ok := f.contents[filename]; ok {
+		return content, nil
+	}
+	read := f.pass.ReadFile
+	if read == nil {
+		read = os.ReadFile
+	}
+	content, err := read(filename)
+	if err != nil {
+		return nil, err
+	}
+	f.contents[filename] = content
+	return content, nil
+}
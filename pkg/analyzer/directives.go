@@ -0,0 +1,223 @@
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	directivePrefix = "//loglinter:ignore"
+
+	// directiveCategory is the category (and rule-config key) used for
+	// diagnostics about the directives themselves.
+	directiveCategory = "directive"
+
+	// allRules is the pseudo rule name that suppresses every rule.
+	allRules = "all"
+)
+
+// directive is a parsed //loglinter:ignore comment.
+//
+// Supported forms:
+//
+//	//loglinter:ignore sensitive -- value is already hashed
+//	//loglinter:ignore english,symbols -- localized product name
+//	//loglinter:ignore all -- generated code
+//
+// A directive placed at the end of a line applies to that line. A directive on
+// its own line applies to the declaration or statement that follows it, so a
+// directive in a function's doc comment covers the whole function. A directive
+// above the package clause covers the whole file.
+type directive struct {
+	used     map[string]bool
+	rules    []string
+	pos, end token.Pos // position of the comment itself
+	from, to token.Pos // suppressed source range
+}
+
+// suppresses reports whether the directive covers a diagnostic of the given rule at pos.
+func (d *directive) suppresses(rule string, pos token.Pos) bool {
+	if pos < d.from || pos >= d.to {
+		return false
+	}
+	for _, name := range d.rules {
+		if name == rule || name == allRules {
+			d.used[name] = true
+			return true
+		}
+	}
+	return false
+}
+
+// parseDirectives collects the ignore directives of every file in the pass.
+// Malformed directives are returned as diagnostics and do not suppress anything.
+func parseDirectives(pass *analysis.Pass, known map[string]bool) ([]*directive, []analysis.Diagnostic) {
+	var (
+		directives []*directive
+		diags      []analysis.Diagnostic
+	)
+
+	for _, file := range pass.Files {
+		tf := pass.Fset.File(file.Pos())
+		if tf == nil {
+			continue
+		}
+		codeLines := collectCodeLines(tf, file)
+
+		for _, group := range file.Comments {
+			for _, c := range group.List {
+				if !strings.HasPrefix(c.Text, directivePrefix) {
+					continue
+				}
+
+				names, err := parseDirectiveText(strings.TrimPrefix(c.Text, directivePrefix), known)
+				if err != nil {
+					diags = append(diags, analysis.Diagnostic{
+						Pos:     c.Pos(),
+						End:     c.End(),
+						Message: fmt.Sprintf("malformed //loglinter:ignore directive: %v", err),
+					})
+					continue
+				}
+
+				from, to := directiveScope(tf, file, group, c, codeLines)
+				directives = append(directives, &directive{
+					pos:   c.Pos(),
+					end:   c.End(),
+					from:  from,
+					to:    to,
+					rules: names,
+					used:  make(map[string]bool),
+				})
+			}
+		}
+	}
+
+	return directives, diags
+}
+
+// parseDirectiveText parses "<rule>[,<rule>...] -- <reason>".
+func parseDirectiveText(text string, known map[string]bool) ([]string, error) {
+	if text != "" && text[0] != ' ' && text[0] != '\t' {
+		return nil, fmt.Errorf("expected a space after %q", directivePrefix)
+	}
+
+	ruleList, reason, found := strings.Cut(text, "--")
+	if !found || strings.TrimSpace(reason) == "" {
+		return nil, fmt.Errorf("missing reason (add \"-- <reason>\")")
+	}
+
+	ruleList = strings.TrimSpace(ruleList)
+	if ruleList == "" {
+		return nil, fmt.Errorf("missing rule names")
+	}
+
+	var names []string
+	for _, name := range strings.Split(ruleList, ",") {
+		name = strings.TrimSpace(name)
+		if name != allRules && !known[name] {
+			return nil, fmt.Errorf("unknown rule %q", name)
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// directiveScope returns the source range suppressed by the directive comment c.
+func directiveScope(tf *token.File, file *ast.File, group *ast.CommentGroup, c *ast.Comment, codeLines map[int]bool) (token.Pos, token.Pos) {
+	// File-level: the directive precedes the package clause.
+	if c.Pos() < file.Package {
+		return file.Pos(), file.End()
+	}
+
+	line := tf.Line(c.Pos())
+
+	// Line-level: the directive trails code on the same line.
+	if codeLines[line] {
+		return lineRange(tf, line)
+	}
+
+	// Otherwise it applies to the outermost node starting right after the comment group.
+	next := tf.Line(group.End()) + 1
+	if next > tf.LineCount() {
+		return c.Pos(), c.Pos()
+	}
+
+	var node ast.Node
+	ast.Inspect(file, func(n ast.Node) bool {
+		if n == nil {
+			return false
+		}
+		switch n.(type) {
+		case *ast.File, *ast.CommentGroup, *ast.Comment:
+			return true
+		}
+		if tf.Line(n.Pos()) == next && (node == nil || n.End() > node.End()) {
+			node = n
+		}
+		return true
+	})
+
+	if node == nil {
+		return lineRange(tf, next)
+	}
+	return node.Pos(), node.End()
+}
+
+// collectCodeLines returns the set of lines on which a syntax node starts or ends.
+func collectCodeLines(tf *token.File, file *ast.File) map[int]bool {
+	lines := make(map[int]bool)
+	ast.Inspect(file, func(n ast.Node) bool {
+		if n == nil {
+			return false
+		}
+		switch n.(type) {
+		case *ast.File, *ast.CommentGroup, *ast.Comment:
+			return true
+		}
+		lines[tf.Line(n.Pos())] = true
+		lines[tf.Line(n.End())] = true
+		return true
+	})
+	return lines
+}
+
+// lineRange returns the range covering the given 1-based line.
+func lineRange(tf *token.File, line int) (token.Pos, token.Pos) {
+	from := tf.LineStart(line)
+	if line < tf.LineCount() {
+		return from, tf.LineStart(line + 1)
+	}
+	return from, token.Pos(tf.Base() + tf.Size())
+}
+
+// unusedDirectives returns diagnostics for directives (or individual rule names
+// within them) that did not suppress anything. Rules that are not enabled are
+// skipped, since their directives cannot be exercised by this configuration.
+func unusedDirectives(directives []*directive, enabled map[string]bool) []analysis.Diagnostic {
+	var diags []analysis.Diagnostic
+	for _, d := range directives {
+		var unused []string
+		for _, name := range d.rules {
+			if d.used[name] || (name != allRules && !enabled[name]) {
+				continue
+			}
+			unused = append(unused, name)
+		}
+		if len(unused) == 0 {
+			continue
+		}
+		sort.Strings(unused)
+		diags = append(diags, analysis.Diagnostic{
+			Pos:     d.pos,
+			End:     d.end,
+			Message: fmt.Sprintf("unused //loglinter:ignore directive for %s", strings.Join(unused, ", ")),
+		})
+	}
+	return diags
+}
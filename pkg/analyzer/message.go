@@ -0,0 +1,199 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+
+	"github.com/AlexanderGhosty/log-linter/pkg/logsupport"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// maxMessageDepth limits how many variable definitions are followed when
+// extracting a message, e.g. msg := prefix + "..." with prefix := "...".
+const maxMessageDepth = 4
+
+// messagePart is a constant fragment of a log message.
+type messagePart struct {
+	// expr is the expression the part is written as.
+	expr ast.Expr
+	// use is the variable of the log call's message the part was reached
+	// through, if it is defined elsewhere (e.g. msg in slog.Info(msg)).
+	use  ast.Expr
+	text string
+	// known is false for a part only known at run time (e.g. a variable).
+	known bool
+	// format is true if the part is a printf-style format string.
+	format bool
+}
+
+// messageExtractor finds the constant fragments of log messages built at run
+// time: concatenations, fmt.Sprintf and fmt.Sprint calls, errors.New(...).Error()
+// and local variables assigned exactly once.
+type messageExtractor struct {
+	pass *analysis.Pass
+	// defs holds the initial value of local variables that are never
+	// reassigned nor have their address taken.
+	defs map[*types.Var]ast.Expr
+}
+
+// newMessageExtractor indexes the single-definition local variables of the package.
+func newMessageExtractor(pass *analysis.Pass, ins *inspector.Inspector) *messageExtractor {
+	defs := make(map[*types.Var]ast.Expr)
+	modified := make(map[*types.Var]bool)
+
+	localVar := func(ident *ast.Ident) *types.Var {
+		v, ok := pass.TypesInfo.ObjectOf(ident).(*types.Var)
+		if !ok || v.IsField() || v.Pkg() == nil || v.Parent() == v.Pkg().Scope() {
+			return nil
+		}
+		return v
+	}
+	define := func(lhs, rhs []ast.Expr) {
+		for i, l := range lhs {
+			ident, ok := l.(*ast.Ident)
+			if !ok {
+				continue
+			}
+			v := localVar(ident)
+			if v == nil {
+				continue
+			}
+			if pass.TypesInfo.Defs[ident] == nil || len(lhs) != len(rhs) {
+				// An assignment, or a definition from a multi-value expression.
+				modified[v] = true
+				continue
+			}
+			defs[v] = rhs[i]
+		}
+	}
+
+	nodeFilter := []ast.Node{
+		(*ast.AssignStmt)(nil),
+		(*ast.ValueSpec)(nil),
+		(*ast.IncDecStmt)(nil),
+		(*ast.UnaryExpr)(nil),
+		(*ast.RangeStmt)(nil),
+	}
+	ins.Preorder(nodeFilter, func(n ast.Node) {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			define(n.Lhs, n.Rhs)
+		case *ast.ValueSpec:
+			lhs := make([]ast.Expr, len(n.Names))
+			for i, name := range n.Names {
+				lhs[i] = name
+			}
+			define(lhs, n.Values)
+		case *ast.IncDecStmt:
+			if ident, ok := n.X.(*ast.Ident); ok {
+				if v := localVar(ident); v != nil {
+					modified[v] = true
+				}
+			}
+		case *ast.UnaryExpr:
+			if ident, ok := ast.Unparen(n.X).(*ast.Ident); ok && n.Op == token.AND {
+				if v := localVar(ident); v != nil {
+					modified[v] = true
+				}
+			}
+		case *ast.RangeStmt:
+			if n.Tok == token.ASSIGN {
+				define([]ast.Expr{n.Key, n.Value}, nil)
+			}
+		}
+	})
+
+	for v := range modified {
+		delete(defs, v)
+	}
+	return &messageExtractor{pass: pass, defs: defs}
+}
+
+// extract returns the parts of the message expr, in order.
+func (e *messageExtractor) extract(expr ast.Expr, depth int) []messagePart {
+	unknown := []messagePart{{expr: expr}}
+
+	if tv, ok := e.pass.TypesInfo.Types[expr]; ok && tv.Value != nil && tv.Value.Kind() == constant.String {
+		return []messagePart{{expr: expr, text: constant.StringVal(tv.Value), known: true}}
+	}
+
+	switch expr := expr.(type) {
+	case *ast.ParenExpr:
+		return e.extract(expr.X, depth)
+	case *ast.BinaryExpr:
+		if expr.Op == token.ADD {
+			return append(e.extract(expr.X, depth), e.extract(expr.Y, depth)...)
+		}
+	case *ast.Ident:
+		if v, ok := e.pass.TypesInfo.Uses[expr].(*types.Var); ok && depth < maxMessageDepth {
+			if def, ok := e.defs[v]; ok {
+				parts := e.extract(def, depth+1)
+				for i := range parts {
+					parts[i].use = expr
+				}
+				return parts
+			}
+		}
+	case *ast.CallExpr:
+		if parts := e.extractCall(expr, depth); parts != nil {
+			return parts
+		}
+	}
+	return unknown
+}
+
+// extractCall returns the parts of a message built by a call to fmt.Sprintf,
+// fmt.Sprint or fmt.Sprintln, or of err.Error() for an error created by
+// errors.New or fmt.Errorf. It returns nil for other calls.
+func (e *messageExtractor) extractCall(call *ast.CallExpr, depth int) []messagePart {
+	if sel, ok := call.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "Error" && len(call.Args) == 0 {
+		if inner, ok := ast.Unparen(sel.X).(*ast.CallExpr); ok {
+			switch logsupport.MessageFunc(e.pass, inner) {
+			case "errors.New":
+				return e.extract(inner.Args[0], depth)
+			case "fmt.Errorf":
+				return e.format(inner)
+			}
+		}
+		return nil
+	}
+
+	switch logsupport.MessageFunc(e.pass, call) {
+	case "fmt.Sprintf":
+		return e.format(call)
+	case "fmt.Sprint", "fmt.Sprintln":
+		var parts []messagePart
+		for _, arg := range call.Args {
+			if tv, ok := e.pass.TypesInfo.Types[arg]; ok && tv.Value != nil && tv.Value.Kind() == constant.String {
+				parts = append(parts, e.extract(arg, depth)...)
+			} else {
+				parts = append(parts, messagePart{expr: arg})
+			}
+		}
+		if len(parts) == 0 {
+			return []messagePart{{expr: call}}
+		}
+		return parts
+	}
+	return nil
+}
+
+// format returns the format string of a printf-style call as a single part.
+func (e *messageExtractor) format(call *ast.CallExpr) []messagePart {
+	if len(call.Args) == 0 {
+		return nil
+	}
+	tv, ok := e.pass.TypesInfo.Types[call.Args[0]]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return []messagePart{{expr: call}}
+	}
+	return []messagePart{{
+		expr:   call.Args[0],
+		text:   constant.StringVal(tv.Value),
+		known:  true,
+		format: true,
+	}}
+}
@@ -0,0 +1,132 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/AlexanderGhosty/log-linter/pkg/logsupport"
+	"golang.org/x/tools/go/analysis"
+)
+
+// exportWrapperFacts marks the functions of the package that forward one of
+// their parameters into the message of a supported log call (see
+// logsupport.WrapperFact). Calls to them, in this or any importing package,
+// are then checked like calls to the underlying logger.
+func exportWrapperFacts(pass *analysis.Pass, registry *logsupport.Registry) {
+	// Repeat until nothing changes, so that wrappers calling other wrappers
+	// declared later in the package are detected as well.
+	for changed := true; changed; {
+		changed = false
+
+		for _, file := range pass.Files {
+			for _, decl := range file.Decls {
+				fd, ok := decl.(*ast.FuncDecl)
+				if !ok || fd.Body == nil {
+					continue
+				}
+
+				fn, ok := pass.TypesInfo.Defs[fd.Name].(*types.Func)
+				if !ok || pass.ImportObjectFact(fn, new(logsupport.WrapperFact)) {
+					continue
+				}
+
+				if fact := findForwarding(pass, registry, fd, fn); fact != nil {
+					pass.ExportObjectFact(fn, fact)
+					changed = true
+				}
+			}
+		}
+	}
+}
+
+// findForwarding returns a WrapperFact if the body of fd passes one of fn's
+// string parameters, unmodified, as the message of a supported log call.
+func findForwarding(pass *analysis.Pass, registry *logsupport.Registry, fd *ast.FuncDecl, fn *types.Func) *logsupport.WrapperFact {
+	sig, ok := fn.Type().(*types.Signature)
+	if !ok {
+		return nil
+	}
+
+	paramIndex := func(expr ast.Expr) int {
+		ident, ok := expr.(*ast.Ident)
+		if !ok {
+			return -1
+		}
+		obj := pass.TypesInfo.Uses[ident]
+		for i := 0; i < sig.Params().Len(); i++ {
+			if sig.Params().At(i) == obj {
+				return i
+			}
+		}
+		return -1
+	}
+
+	assigned := assignedParams(pass, fd.Body)
+
+	var fact *logsupport.WrapperFact
+	ast.Inspect(fd.Body, func(n ast.Node) bool {
+		if fact != nil {
+			return false
+		}
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+
+		lc, ok := registry.Resolve(pass, call)
+		if !ok || lc.MessageIndex < 0 || lc.MessageIndex >= len(call.Args) {
+			return true
+		}
+
+		msgParam := paramIndex(call.Args[lc.MessageIndex])
+		if msgParam < 0 || assigned[sig.Params().At(msgParam)] || !isStringType(sig.Params().At(msgParam).Type()) {
+			return true
+		}
+
+		argsParam := -1
+		if last := len(call.Args) - 1; call.Ellipsis.IsValid() && last == lc.ArgsIndex {
+			if i := paramIndex(call.Args[last]); sig.Variadic() && i == sig.Params().Len()-1 {
+				argsParam = i
+			}
+		}
+		// Printf and Print calls format every argument after the message; only
+		// accept them if the forwarded arguments directly follow the message.
+		if (lc.Printf || lc.Print) && argsParam >= 0 && argsParam != msgParam+1 {
+			return true
+		}
+
+		fact = &logsupport.WrapperFact{
+			Package:      lc.Package,
+			Func:         lc.Func,
+			MessageIndex: msgParam,
+			ArgsIndex:    argsParam,
+			KeyValues:    lc.KeyValues && argsParam >= 0,
+		}
+		return false
+	})
+
+	return fact
+}
+
+// assignedParams returns the variables that are assigned to within body.
+func assignedParams(pass *analysis.Pass, body *ast.BlockStmt) map[types.Object]bool {
+	assigned := make(map[types.Object]bool)
+	ast.Inspect(body, func(n ast.Node) bool {
+		if assign, ok := n.(*ast.AssignStmt); ok {
+			for _, lhs := range assign.Lhs {
+				if ident, ok := lhs.(*ast.Ident); ok {
+					if obj := pass.TypesInfo.ObjectOf(ident); obj != nil {
+						assigned[obj] = true
+					}
+				}
+			}
+		}
+		return true
+	})
+	return assigned
+}
+
+func isStringType(t types.Type) bool {
+	basic, ok := t.Underlying().(*types.Basic)
+	return ok && basic.Kind() == types.String
+}
@@ -0,0 +1,251 @@
+// Package baseline records existing findings so that only new ones are
+// reported, which makes it possible to adopt the linter on legacy code.
+//
+// Findings are identified by file, rule name and a fingerprint of the
+// diagnostic message and the flagged source text, not by line number, so
+// that baselined findings survive unrelated edits to the file.
+package baseline
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"go/scanner"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// version is the version of the baseline file format.
+const version = 1
+
+// Entry is a baselined finding. Count is the number of identical findings
+// (same file, rule and fingerprint) that are suppressed.
+type Entry struct {
+	// File is the path of the file. In the baseline file it is relative to the
+	// baseline file and uses forward slashes.
+	File        string `json:"file"`
+	Rule        string `json:"rule"`
+	Fingerprint string `json:"fingerprint"`
+	Count       int    `json:"count"`
+}
+
+type fileFormat struct {
+	Entries []Entry `json:"entries"`
+	Version int     `json:"version"`
+}
+
+type key struct {
+	rule        string
+	fingerprint string
+}
+
+// Baseline is a set of baselined findings.
+type Baseline struct {
+	// files maps absolute file paths to the counts of their findings.
+	files map[string]map[key]int
+}
+
+// New returns an empty baseline.
+func New() *Baseline {
+	return &Baseline{files: make(map[string]map[key]int)}
+}
+
+// Load reads a baseline file. File paths in the baseline are relative to the
+// directory of the baseline file.
+func Load(path string) (*Baseline, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var f fileFormat
+	if err = json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("baseline %s: %w", path, err)
+	}
+	if f.Version != version {
+		return nil, fmt.Errorf("baseline %s: unsupported version %d", path, f.Version)
+	}
+
+	dir, err := filepath.Abs(filepath.Dir(path))
+	if err != nil {
+		return nil, err
+	}
+	b := New()
+	for _, e := range f.Entries {
+		b.add(filepath.Join(dir, filepath.FromSlash(e.File)), e.Rule, e.Fingerprint, e.Count)
+	}
+	return b, nil
+}
+
+// Add records a finding in the named file.
+func (b *Baseline) Add(filename, rule, fingerprint string) {
+	b.add(absPath(filename), rule, fingerprint, 1)
+}
+
+func (b *Baseline) add(file, rule, fingerprint string, count int) {
+	counts := b.files[file]
+	if counts == nil {
+		counts = make(map[key]int)
+		b.files[file] = counts
+	}
+	counts[key{rule: rule, fingerprint: fingerprint}] += count
+}
+
+// Len returns the number of baselined findings.
+func (b *Baseline) Len() int {
+	n := 0
+	for _, counts := range b.files {
+		for _, count := range counts {
+			n += count
+		}
+	}
+	return n
+}
+
+// Write stores the baseline at path, with file paths relative to its directory.
+func (b *Baseline) Write(path string) error {
+	dir, err := filepath.Abs(filepath.Dir(path))
+	if err != nil {
+		return err
+	}
+
+	entries := []Entry{}
+	for file, counts := range b.files {
+		if rel, relErr := filepath.Rel(dir, file); relErr == nil {
+			file = rel
+		}
+		for k, n := range counts {
+			entries = append(entries, Entry{File: filepath.ToSlash(file), Rule: k.rule, Fingerprint: k.fingerprint, Count: n})
+		}
+	}
+	sortEntries(entries)
+
+	data, err := json.MarshalIndent(fileFormat{Version: version, Entries: entries}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+func absPath(filename string) string {
+	if abs, err := filepath.Abs(filename); err == nil {
+		return abs
+	}
+	return filepath.Clean(filename)
+}
+
+// Matcher tracks which baselined findings have been matched. Each analysis
+// pass uses its own Matcher, since the same file may be analyzed more than
+// once (e.g. as part of a package and its test variant).
+type Matcher struct {
+	baseline *Baseline
+	used     map[string]map[key]int
+}
+
+// NewMatcher returns a Matcher with no findings matched yet.
+func (b *Baseline) NewMatcher() *Matcher {
+	return &Matcher{baseline: b, used: make(map[string]map[key]int)}
+}
+
+// Suppress reports whether a finding is baselined, consuming one occurrence
+// of the matching entry.
+func (m *Matcher) Suppress(filename, rule, fingerprint string) bool {
+	file := absPath(filename)
+	k := key{rule: rule, fingerprint: fingerprint}
+	if m.used[file][k] >= m.baseline.files[file][k] {
+		return false
+	}
+	if m.used[file] == nil {
+		m.used[file] = make(map[key]int)
+	}
+	m.used[file][k]++
+	return true
+}
+
+// Stale returns the baselined findings of the file that were not matched.
+// Count is the number of unmatched occurrences, and File is the absolute path.
+func (m *Matcher) Stale(filename string) []Entry {
+	file := absPath(filename)
+	var stale []Entry
+	for k, n := range m.baseline.files[file] {
+		if left := n - m.used[file][k]; left > 0 {
+			stale = append(stale, Entry{File: file, Rule: k.rule, Fingerprint: k.fingerprint, Count: left})
+		}
+	}
+	sortEntries(stale)
+	return stale
+}
+
+func sortEntries(entries []Entry) {
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Rule != b.Rule {
+			return a.Rule < b.Rule
+		}
+		return a.Fingerprint < b.Fingerprint
+	})
+}
+
+// Fingerprint identifies a finding by its rule, diagnostic message and the
+// flagged source text. Whitespace in the source is normalized, so the
+// fingerprint does not change when the code is reformatted.
+func Fingerprint(rule, message, source string) string {
+	h := sha256.New()
+	for _, s := range []string{rule, message, strings.Join(strings.Fields(source), " ")} {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+// Source returns the source text between pos and end, or "" if it cannot be
+// read. A range inside a string literal is widened to the whole literal, so
+// that the fingerprint does not depend on which characters of the literal a
+// rule points at. readFile defaults to os.ReadFile.
+func Source(fset *token.FileSet, pos, end token.Pos, readFile func(string) ([]byte, error)) string {
+	if !pos.IsValid() || !end.IsValid() || end < pos {
+		return ""
+	}
+	if readFile == nil {
+		readFile = os.ReadFile
+	}
+	file := fset.File(pos)
+	if file == nil || int(end)-file.Base() > file.Size() {
+		return ""
+	}
+	content, err := readFile(file.Name())
+	if err != nil {
+		return ""
+	}
+	start, stop := file.Offset(pos), file.Offset(end)
+	if stop > len(content) {
+		return ""
+	}
+	start, stop = enclosingLiteral(content, start, stop)
+	return string(content[start:stop])
+}
+
+// enclosingLiteral returns the offsets of the string literal of src that
+// contains [start, stop), or start and stop if there is none.
+func enclosingLiteral(src []byte, start, stop int) (int, int) {
+	file := token.NewFileSet().AddFile("", -1, len(src))
+	var s scanner.Scanner
+	s.Init(file, src, nil, 0)
+	for {
+		pos, tok, lit := s.Scan()
+		offset := file.Offset(pos)
+		if tok == token.EOF || offset > start {
+			return start, stop
+		}
+		if tok == token.STRING && stop <= offset+len(lit) {
+			return offset, offset + len(lit)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package baseline
+
+import (
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFingerprint(t *testing.T) {
+	base := Fingerprint("lowercase", "log message should start with a lowercase letter", `"Starting server"`)
+
+	tests := []struct {
+		name    string
+		rule    string
+		message string
+		source  string
+		same    bool
+	}{
+		{name: "identical", rule: "lowercase", message: "log message should start with a lowercase letter", source: `"Starting server"`, same: true},
+		{name: "reformatted source", rule: "lowercase", message: "log message should start with a lowercase letter", source: "  \"Starting server\"\n", same: true},
+		{name: "other rule", rule: "symbols", message: "log message should start with a lowercase letter", source: `"Starting server"`, same: false},
+		{name: "other message", rule: "lowercase", message: "log message should be in English", source: `"Starting server"`, same: false},
+		{name: "other source", rule: "lowercase", message: "log message should start with a lowercase letter", source: `"Stopping server"`, same: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Fingerprint(tt.rule, tt.message, tt.source) == base; got != tt.same {
+				t.Errorf("Fingerprint() equal = %v, want %v", got, tt.same)
+			}
+		})
+	}
+}
+
+func TestBaseline_WriteLoad(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "pkg", "main.go")
+
+	b := New()
+	b.Add(file, "lowercase", "aaaa")
+	b.Add(file, "lowercase", "aaaa")
+	b.Add(file, "sensitive", "bbbb")
+
+	path := filepath.Join(dir, "loglinter-baseline.json")
+	if err := b.Write(path); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"file": "pkg/main.go"`) {
+		t.Errorf("baseline file paths should be relative to the baseline:\n%s", data)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Len() != 3 {
+		t.Errorf("Len() = %d, want 3", loaded.Len())
+	}
+
+	m := loaded.NewMatcher()
+	for i, want := range []bool{true, true, false} {
+		if got := m.Suppress(file, "lowercase", "aaaa"); got != want {
+			t.Errorf("Suppress() #%d = %v, want %v", i, got, want)
+		}
+	}
+	if m.Suppress(file, "lowercase", "cccc") {
+		t.Error("Suppress() of an unknown fingerprint = true, want false")
+	}
+	if m.Suppress(filepath.Join(dir, "other.go"), "sensitive", "bbbb") {
+		t.Error("Suppress() in another file = true, want false")
+	}
+
+	stale := m.Stale(file)
+	if len(stale) != 1 || stale[0].Rule != "sensitive" || stale[0].Count != 1 {
+		t.Errorf("Stale() = %+v, want the sensitive entry", stale)
+	}
+
+	// Each matcher starts over.
+	if !loaded.NewMatcher().Suppress(file, "sensitive", "bbbb") {
+		t.Error("Suppress() with a new matcher = false, want true")
+	}
+}
+
+func TestLoad_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "invalid JSON", content: "{"},
+		{name: "unknown version", content: `{"version": 2, "entries": []}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "baseline.json")
+			if err := os.WriteFile(path, []byte(tt.content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := Load(path); err == nil {
+				t.Error("Load() succeeded, want error")
+			}
+		})
+	}
+}
+
+func TestSource(t *testing.T) {
+	const content = "package p\n\nvar s = \"Hello\"\n"
+	fset := token.NewFileSet()
+	file := fset.AddFile("p.go", -1, len(content))
+	readFile := func(string) ([]byte, error) { return []byte(content), nil }
+
+	start := strings.Index(content, `"Hello"`)
+	pos, end := file.Pos(start), file.Pos(start+len(`"Hello"`))
+	if got := Source(fset, pos, end, readFile); got != `"Hello"` {
+		t.Errorf("Source() = %q, want %q", got, `"Hello"`)
+	}
+	// A range inside the literal is widened to all of it.
+	if got := Source(fset, pos+1, pos+2, readFile); got != `"Hello"` {
+		t.Errorf("Source() of %q = %q, want %q", "H", got, `"Hello"`)
+	}
+	if got := Source(fset, pos, token.NoPos, readFile); got != "" {
+		t.Errorf("Source() without end = %q, want empty", got)
+	}
+}
@@ -3,28 +3,134 @@ package config
 
 import (
 	"fmt"
+	"maps"
+	"path"
 	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/AlexanderGhosty/log-linter/pkg/utils"
+)
+
+// Severity levels that can be assigned to a rule.
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+	SeverityInfo    = "info"
 )
 
 // Config holds the main configuration for the linter.
 type Config struct {
-	Symbols   SymbolsConfig   `mapstructure:"symbols"`
-	Sensitive SensitiveConfig `mapstructure:"sensitive"`
-	Loggers   []LoggerConfig  `mapstructure:"loggers"`
+	Rules     map[string]RuleConfig `mapstructure:"rules"`
+	Symbols   SymbolsConfig         `mapstructure:"symbols"`
+	Sensitive SensitiveConfig       `mapstructure:"sensitive"`
+	// Baseline is the path of a baseline file (see package baseline); findings
+	// recorded in it are not reported.
+	Baseline string         `mapstructure:"baseline"`
+	Loggers  []LoggerConfig `mapstructure:"loggers"`
+	English  EnglishConfig  `mapstructure:"english"`
+	KeyStyle KeyStyleConfig `mapstructure:"key_style"`
+	Spelling SpellingConfig `mapstructure:"spelling"`
+	// LogAndReturn holds the settings of the log-and-return rule.
+	LogAndReturn LogAndReturnConfig `mapstructure:"log_and_return"`
+	Secrets      SecretsConfig      `mapstructure:"secrets"`
 }
 
 // Validate checks the configuration for errors.
 func (c *Config) Validate() error {
+	for name, rc := range c.Rules {
+		if err := rc.Validate(); err != nil {
+			return fmt.Errorf("rule %q config error: %w", name, err)
+		}
+	}
 	if err := c.Sensitive.Validate(); err != nil {
 		return fmt.Errorf("sensitive config error: %w", err)
 	}
+	if err := c.Secrets.Validate(); err != nil {
+		return fmt.Errorf("secrets config error: %w", err)
+	}
+	if err := c.English.Validate(); err != nil {
+		return fmt.Errorf("english config error: %w", err)
+	}
+	if err := c.KeyStyle.Validate(); err != nil {
+		return fmt.Errorf("key_style config error: %w", err)
+	}
+	if err := c.LogAndReturn.Validate(); err != nil {
+		return fmt.Errorf("log_and_return config error: %w", err)
+	}
+	for i := range c.Loggers {
+		if err := c.Loggers[i].Validate(); err != nil {
+			return fmt.Errorf("logger %q config error: %w", c.Loggers[i].Package, err)
+		}
+	}
+	return nil
+}
+
+// ValidateRuleNames checks that the rules settings only name known rules,
+// so that a misspelled rule name is not silently ignored.
+func (c *Config) ValidateRuleNames(known []string) error {
+	for _, name := range slices.Sorted(maps.Keys(c.Rules)) {
+		if !slices.Contains(known, name) {
+			return fmt.Errorf("unknown rule %q (expected one of %s)", name, strings.Join(known, ", "))
+		}
+	}
 	return nil
 }
 
+// IsRuleEnabled reports whether the rule with the given name should run.
+// Rules without an explicit "enabled" setting fall back to defaultEnabled.
+func (c *Config) IsRuleEnabled(name string, defaultEnabled bool) bool {
+	if rc, ok := c.Rules[name]; ok && rc.Enabled != nil {
+		return *rc.Enabled
+	}
+	return defaultEnabled
+}
+
+// RuleSeverity returns the configured severity for the rule, defaulting to SeverityError.
+func (c *Config) RuleSeverity(name string) string {
+	if rc, ok := c.Rules[name]; ok && rc.Severity != "" {
+		return rc.Severity
+	}
+	return SeverityError
+}
+
+// RuleConfig holds per-rule settings, keyed by the rule name (e.g. "english").
+type RuleConfig struct {
+	// Enabled turns the rule on or off. If unset, the rule's default is used.
+	Enabled *bool `mapstructure:"enabled"`
+	// Severity of the reported diagnostics: "error", "warning" or "info".
+	Severity string `mapstructure:"severity"`
+}
+
+// Validate checks the rule configuration for errors.
+func (c *RuleConfig) Validate() error {
+	switch c.Severity {
+	case "", SeverityError, SeverityWarning, SeverityInfo:
+		return nil
+	}
+	return fmt.Errorf("invalid severity %q (expected %q, %q or %q)",
+		c.Severity, SeverityError, SeverityWarning, SeverityInfo)
+}
+
 // SensitiveConfig holds configuration for sensitive data detection.
 type SensitiveConfig struct {
+	// Redact is the fully qualified name of a function (e.g.
+	// "example.com/redact.String") that suggested fixes wrap sensitive string
+	// attribute values in. If unset, the fixes replace the value with
+	// "[REDACTED]".
+	Redact   string   `mapstructure:"redact"`
 	Keywords []string `mapstructure:"keywords"`
 	Patterns []string `mapstructure:"patterns"`
+	// Types lists fully qualified named types (e.g. "example.com/auth.Token")
+	// whose values must not be logged.
+	Types []string `mapstructure:"types"`
+	// TaintSources lists the functions, by their fully qualified name (e.g.
+	// "os.Getenv" or "(*net/http.Request).FormValue"), whose result is
+	// sensitive when called with a constant argument that contains a
+	// sensitive keyword. If unset, common request and environment accessors
+	// are used.
+	TaintSources []string `mapstructure:"taint_sources"`
 }
 
 // Validate checks the sensitive configuration for errors.
@@ -34,6 +140,187 @@ func (c *SensitiveConfig) Validate() error {
 			return fmt.Errorf("invalid sensitive pattern %q: %w", p, err)
 		}
 	}
+	for _, t := range c.Types {
+		if err := validateTypeName(t); err != nil {
+			return err
+		}
+	}
+	for _, fn := range c.TaintSources {
+		if strings.TrimSpace(fn) == "" {
+			return fmt.Errorf("empty taint source")
+		}
+	}
+	if c.Redact != "" {
+		if err := validateQualifiedName(c.Redact, "function", "FuncName"); err != nil {
+			return fmt.Errorf("redact: %w", err)
+		}
+	}
+	return nil
+}
+
+// SecretsConfig holds configuration for the detection of hard-coded secrets.
+type SecretsConfig struct {
+	// MinEntropy is the Shannon entropy, in bits per character, from which a
+	// string is treated as a secret. Zero means the default (4.0).
+	MinEntropy float64 `mapstructure:"min_entropy"`
+	// MinLength is the minimum length of a high-entropy string. Zero means
+	// the default (20).
+	MinLength int `mapstructure:"min_length"`
+}
+
+// Validate checks the secrets configuration for errors.
+func (c *SecretsConfig) Validate() error {
+	if c.MinEntropy < 0 {
+		return fmt.Errorf("min_entropy must not be negative, got %v", c.MinEntropy)
+	}
+	if c.MinLength < 0 {
+		return fmt.Errorf("min_length must not be negative, got %d", c.MinLength)
+	}
+	return nil
+}
+
+// EnglishConfig holds configuration for the english rule.
+type EnglishConfig struct {
+	// Overrides replace the settings for the packages they match; the first
+	// matching override applies.
+	Overrides       []EnglishOverride `mapstructure:"overrides"`
+	EnglishSettings `mapstructure:",squash"`
+}
+
+// Validate checks the english configuration for errors.
+func (c *EnglishConfig) Validate() error {
+	if err := c.EnglishSettings.Validate(); err != nil {
+		return err
+	}
+	for i := range c.Overrides {
+		if err := c.Overrides[i].Validate(); err != nil {
+			return fmt.Errorf("override %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// EnglishSettings holds the letters allowed in log messages.
+type EnglishSettings struct {
+	// Language is the ISO 639-1 code of the language log messages must be
+	// written in (e.g. "ru"). If unset, messages must be in English.
+	Language string `mapstructure:"language"`
+	// Scripts lists Unicode scripts (e.g. "Latin", "Cyrillic") whose letters
+	// are allowed in addition to those of the language.
+	Scripts []string `mapstructure:"scripts"`
+	// Ranges lists additional allowed rune ranges, e.g. "U+00C0-U+00FF" or "é".
+	Ranges []string `mapstructure:"ranges"`
+	// Words lists words that are allowed whatever their letters (e.g. "café").
+	Words []string `mapstructure:"words"`
+}
+
+// Validate checks the english settings for errors.
+func (c *EnglishSettings) Validate() error {
+	if c.Language != "" {
+		if _, ok := utils.LookupLanguage(c.Language); !ok {
+			return fmt.Errorf("unknown language %q (expected an ISO 639-1 code such as \"en\")", c.Language)
+		}
+	}
+	for _, s := range c.Scripts {
+		if _, ok := unicode.Scripts[s]; !ok {
+			return fmt.Errorf("unknown Unicode script %q", s)
+		}
+	}
+	for _, r := range c.Ranges {
+		if _, _, err := utils.ParseRuneRange(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EnglishOverride applies its own english settings to a set of packages.
+type EnglishOverride struct {
+	// Packages lists import path patterns: "example.com/app/..." matches a
+	// package and the packages below it, other patterns are matched with
+	// path.Match (e.g. "example.com/*/i18n").
+	Packages        []string `mapstructure:"packages"`
+	EnglishSettings `mapstructure:",squash"`
+}
+
+// Validate checks the override for errors.
+func (c *EnglishOverride) Validate() error {
+	if len(c.Packages) == 0 {
+		return fmt.Errorf("no packages")
+	}
+	for _, p := range c.Packages {
+		if _, err := path.Match(p, ""); err != nil {
+			return fmt.Errorf("invalid package pattern %q: %w", p, err)
+		}
+	}
+	return c.EnglishSettings.Validate()
+}
+
+// SpellingConfig holds configuration for the spelling rule.
+type SpellingConfig struct {
+	// Words lists additional correctly spelled words (e.g. product names).
+	Words []string `mapstructure:"words"`
+	// Dictionaries lists files of additional words, one per line; lines
+	// starting with '#' are comments.
+	Dictionaries []string `mapstructure:"dictionaries"`
+}
+
+// KeyStyleConfig holds configuration for the key-style rule.
+type KeyStyleConfig struct {
+	// Style is the naming convention of log attribute keys: "snake_case"
+	// (the default), "camelCase", "kebab-case", "dotted" or "regex".
+	Style string `mapstructure:"style"`
+	// Pattern is the regular expression keys must match with the "regex"
+	// style.
+	Pattern string `mapstructure:"pattern"`
+}
+
+// Validate checks the key style configuration for errors.
+func (c *KeyStyleConfig) Validate() error {
+	if c.Style == "regex" {
+		if c.Pattern == "" {
+			return fmt.Errorf("style \"regex\" requires a pattern")
+		}
+		if _, err := regexp.Compile(c.Pattern); err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", c.Pattern, err)
+		}
+		return nil
+	}
+	if c.Pattern != "" {
+		return fmt.Errorf("pattern requires style \"regex\"")
+	}
+	if c.Style != "" {
+		if _, ok := utils.LookupKeyStyle(c.Style); !ok {
+			return fmt.Errorf("unknown style %q (expected snake_case, camelCase, kebab-case, dotted or regex)", c.Style)
+		}
+	}
+	return nil
+}
+
+// LogAndReturnConfig holds configuration for the log-and-return rule.
+type LogAndReturnConfig struct {
+	// ExemptFunctions lists functions, by their fully qualified name (e.g.
+	// "example.com/app/cmd.run" or "(*example.com/app/api.Server).handle"),
+	// that may log the errors they return, such as top-level handlers.
+	// Function literals declared in them are exempt too.
+	ExemptFunctions []string `mapstructure:"exempt_functions"`
+	// ExemptPackages lists import path patterns, as for english overrides,
+	// of packages whose functions are exempt.
+	ExemptPackages []string `mapstructure:"exempt_packages"`
+}
+
+// Validate checks the log-and-return configuration for errors.
+func (c *LogAndReturnConfig) Validate() error {
+	for _, fn := range c.ExemptFunctions {
+		if err := validateQualifiedName(fn, "function", "FuncName"); err != nil {
+			return err
+		}
+	}
+	for _, p := range c.ExemptPackages {
+		if _, err := path.Match(p, ""); err != nil {
+			return fmt.Errorf("invalid package pattern %q: %w", p, err)
+		}
+	}
 	return nil
 }
 
@@ -46,10 +333,86 @@ type SymbolsConfig struct {
 type LoggerConfig struct {
 	// Package path of the logger (e.g. "log/slog", "go.uber.org/zap")
 	Package string `mapstructure:"package"`
-	// Implementation type: "slog", "zap", "generic"
+	// Implementation type: "slog", "zap", "std", "zerolog", "logrus", "generic"
 	UserType string `mapstructure:"user_type"`
 	// Names of field constructors (e.g. "String", "Int")
 	FieldConstructors []string `mapstructure:"field_constructors"`
+	// Fully qualified named types (e.g. "github.com/my/app/log.Logger") whose methods
+	// are treated as this logger, wherever they are declared. For interface types,
+	// any receiver implementing the interface matches.
+	Types []string `mapstructure:"types"`
+	// Methods restricts a "generic" logger to the listed functions and methods.
+	// If empty, every function in the package is treated as a log call.
+	Methods []MethodConfig `mapstructure:"methods"`
 	// Index of the message argument in the log call
 	MessageIndex int `mapstructure:"message_index"`
 }
+
+// Validate checks the logger configuration for errors.
+func (c *LoggerConfig) Validate() error {
+	for _, t := range c.Types {
+		if err := validateTypeName(t); err != nil {
+			return err
+		}
+	}
+	if len(c.Methods) > 0 && c.UserType != "generic" {
+		return fmt.Errorf("methods are only supported for user_type \"generic\", got %q", c.UserType)
+	}
+	for i := range c.Methods {
+		if err := c.Methods[i].Validate(); err != nil {
+			return fmt.Errorf("method %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// validateTypeName checks that t is a fully qualified type name.
+func validateTypeName(t string) error {
+	return validateQualifiedName(t, "type", "TypeName")
+}
+
+// validateQualifiedName checks that name has the form "import/path.Name".
+func validateQualifiedName(name, kind, example string) error {
+	dot := strings.LastIndex(name, ".")
+	if dot <= 0 || dot == len(name)-1 || strings.LastIndex(name, "/") > dot {
+		return fmt.Errorf("invalid %s %q (expected \"import/path.%s\")", kind, name, example)
+	}
+	return nil
+}
+
+// MethodConfig describes a single logging function or method of a generic logger.
+type MethodConfig struct {
+	// MessageIndex overrides the logger's message_index for this method.
+	MessageIndex *int `mapstructure:"message_index"`
+	// ArgsIndex is the index of the first key-value argument. If unset, the
+	// method takes no key-value pairs.
+	ArgsIndex *int `mapstructure:"args_index"`
+	// Exact function or method name (e.g. "Info").
+	Name string `mapstructure:"name"`
+	// Regular expression matched against the whole name (e.g. "(Info|Warn|Error)f?").
+	Pattern string `mapstructure:"pattern"`
+	// Receiver type name (e.g. "Logger"). If empty, package-level functions and
+	// methods of any receiver match.
+	Receiver string `mapstructure:"receiver"`
+	// Printf marks the message as a printf-style format string.
+	Printf bool `mapstructure:"printf"`
+}
+
+// Validate checks the method configuration for errors.
+func (c *MethodConfig) Validate() error {
+	if (c.Name == "") == (c.Pattern == "") {
+		return fmt.Errorf("exactly one of name or pattern must be set")
+	}
+	if c.Pattern != "" {
+		if _, err := regexp.Compile(c.Pattern); err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", c.Pattern, err)
+		}
+	}
+	if c.MessageIndex != nil && *c.MessageIndex < 0 {
+		return fmt.Errorf("message_index must not be negative")
+	}
+	if c.ArgsIndex != nil && *c.ArgsIndex < 0 {
+		return fmt.Errorf("args_index must not be negative")
+	}
+	return nil
+}
@@ -29,6 +29,48 @@ func TestSensitiveConfig_Validate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "valid types",
+			cfg: &SensitiveConfig{
+				Types: []string{"example.com/auth.Token"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "unqualified type",
+			cfg: &SensitiveConfig{
+				Types: []string{"Token"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid taint sources",
+			cfg: &SensitiveConfig{
+				TaintSources: []string{"os.Getenv", "(*net/http.Request).FormValue"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "empty taint source",
+			cfg: &SensitiveConfig{
+				TaintSources: []string{" "},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid redact function",
+			cfg: &SensitiveConfig{
+				Redact: "example.com/redact.String",
+			},
+			wantErr: false,
+		},
+		{
+			name: "unqualified redact function",
+			cfg: &SensitiveConfig{
+				Redact: "String",
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -39,3 +81,249 @@ func TestSensitiveConfig_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestRuleConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		severity string
+		wantErr  bool
+	}{
+		{name: "default severity", severity: "", wantErr: false},
+		{name: "error", severity: "error", wantErr: false},
+		{name: "warning", severity: "warning", wantErr: false},
+		{name: "info", severity: "info", wantErr: false},
+		{name: "unknown severity", severity: "fatal", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Rules: map[string]RuleConfig{"lowercase": {Severity: tt.severity}}}
+			if err := cfg.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConfig_RuleSettings(t *testing.T) {
+	disabled := false
+	cfg := &Config{
+		Rules: map[string]RuleConfig{
+			"english":   {Enabled: &disabled},
+			"sensitive": {Severity: SeverityWarning},
+		},
+	}
+
+	if cfg.IsRuleEnabled("english", true) {
+		t.Error("expected 'english' to be disabled")
+	}
+	if !cfg.IsRuleEnabled("sensitive", true) {
+		t.Error("expected 'sensitive' to keep its default (enabled)")
+	}
+	if cfg.IsRuleEnabled("unknown", false) {
+		t.Error("expected unconfigured rule to keep its default (disabled)")
+	}
+
+	if got := cfg.RuleSeverity("sensitive"); got != SeverityWarning {
+		t.Errorf("RuleSeverity(sensitive) = %q, want %q", got, SeverityWarning)
+	}
+	if got := cfg.RuleSeverity("lowercase"); got != SeverityError {
+		t.Errorf("RuleSeverity(lowercase) = %q, want %q", got, SeverityError)
+	}
+}
+
+func TestConfig_ValidateRuleNames(t *testing.T) {
+	known := []string{"english", "sensitive"}
+	tests := []struct {
+		rules   map[string]RuleConfig
+		name    string
+		wantErr bool
+	}{
+		{name: "no rules", rules: nil, wantErr: false},
+		{name: "known rules", rules: map[string]RuleConfig{"english": {}, "sensitive": {}}, wantErr: false},
+		{name: "misspelled rule", rules: map[string]RuleConfig{"englsh": {}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Rules: tt.rules}
+			if err := cfg.ValidateRuleNames(known); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateRuleNames() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoggerConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		types   []string
+		wantErr bool
+	}{
+		{name: "no types", types: nil, wantErr: false},
+		{name: "qualified type", types: []string{"github.com/my/app/log.Logger"}, wantErr: false},
+		{name: "std type", types: []string{"log/slog.Logger"}, wantErr: false},
+		{name: "missing type name", types: []string{"github.com/my/app/log"}, wantErr: true},
+		{name: "trailing dot", types: []string{"github.com/my/app/log."}, wantErr: true},
+		{name: "missing package", types: []string{".Logger"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Loggers: []LoggerConfig{{Package: "my/log", Types: tt.types}}}
+			if err := cfg.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMethodConfig_Validate(t *testing.T) {
+	negative := -1
+	tests := []struct {
+		name     string
+		userType string
+		method   MethodConfig
+		wantErr  bool
+	}{
+		{name: "name", userType: "generic", method: MethodConfig{Name: "Info"}, wantErr: false},
+		{name: "pattern", userType: "generic", method: MethodConfig{Pattern: "(Info|Warn)f?"}, wantErr: false},
+		{name: "name and pattern", userType: "generic", method: MethodConfig{Name: "Info", Pattern: "Info"}, wantErr: true},
+		{name: "neither name nor pattern", userType: "generic", method: MethodConfig{Receiver: "Logger"}, wantErr: true},
+		{name: "invalid pattern", userType: "generic", method: MethodConfig{Pattern: "("}, wantErr: true},
+		{name: "negative message index", userType: "generic", method: MethodConfig{Name: "Info", MessageIndex: &negative}, wantErr: true},
+		{name: "negative args index", userType: "generic", method: MethodConfig{Name: "Info", ArgsIndex: &negative}, wantErr: true},
+		{name: "non-generic logger", userType: "slog", method: MethodConfig{Name: "Info"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Loggers: []LoggerConfig{{Package: "my/log", UserType: tt.userType, Methods: []MethodConfig{tt.method}}}}
+			if err := cfg.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSecretsConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     SecretsConfig
+		wantErr bool
+	}{
+		{name: "defaults", cfg: SecretsConfig{}, wantErr: false},
+		{name: "custom thresholds", cfg: SecretsConfig{MinEntropy: 4.5, MinLength: 32}, wantErr: false},
+		{name: "negative entropy", cfg: SecretsConfig{MinEntropy: -1}, wantErr: true},
+		{name: "negative length", cfg: SecretsConfig{MinLength: -1}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Secrets: tt.cfg}
+			if err := cfg.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEnglishConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     EnglishConfig
+		wantErr bool
+	}{
+		{name: "defaults", cfg: EnglishConfig{}, wantErr: false},
+		{
+			name: "all settings",
+			cfg: EnglishConfig{EnglishSettings: EnglishSettings{
+				Language: "ru", Scripts: []string{"Latin"}, Ranges: []string{"U+00C0-U+00FF", "é"}, Words: []string{"café"},
+			}},
+			wantErr: false,
+		},
+		{name: "unknown language", cfg: EnglishConfig{EnglishSettings: EnglishSettings{Language: "klingon"}}, wantErr: true},
+		{name: "unknown script", cfg: EnglishConfig{EnglishSettings: EnglishSettings{Scripts: []string{"Elvish"}}}, wantErr: true},
+		{name: "invalid range", cfg: EnglishConfig{EnglishSettings: EnglishSettings{Ranges: []string{"U+00FF-U+00C0"}}}, wantErr: true},
+		{
+			name: "override",
+			cfg: EnglishConfig{Overrides: []EnglishOverride{{
+				Packages: []string{"example.com/app/..."}, EnglishSettings: EnglishSettings{Language: "de"},
+			}}},
+			wantErr: false,
+		},
+		{name: "override without packages", cfg: EnglishConfig{Overrides: []EnglishOverride{{}}}, wantErr: true},
+		{
+			name: "override with invalid pattern",
+			cfg: EnglishConfig{Overrides: []EnglishOverride{{
+				Packages: []string{"example.com/["},
+			}}},
+			wantErr: true,
+		},
+		{
+			name: "override with invalid settings",
+			cfg: EnglishConfig{Overrides: []EnglishOverride{{
+				Packages: []string{"example.com/app"}, EnglishSettings: EnglishSettings{Scripts: []string{"Elvish"}},
+			}}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{English: tt.cfg}
+			if err := cfg.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestKeyStyleConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     KeyStyleConfig
+		wantErr bool
+	}{
+		{name: "defaults", cfg: KeyStyleConfig{}, wantErr: false},
+		{name: "camelCase", cfg: KeyStyleConfig{Style: "camelCase"}, wantErr: false},
+		{name: "regex", cfg: KeyStyleConfig{Style: "regex", Pattern: `^[a-z]+(\.[a-z]+)*$`}, wantErr: false},
+		{name: "unknown style", cfg: KeyStyleConfig{Style: "PascalCase"}, wantErr: true},
+		{name: "regex without pattern", cfg: KeyStyleConfig{Style: "regex"}, wantErr: true},
+		{name: "invalid pattern", cfg: KeyStyleConfig{Style: "regex", Pattern: "("}, wantErr: true},
+		{name: "pattern without regex style", cfg: KeyStyleConfig{Pattern: "^[a-z]+$"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{KeyStyle: tt.cfg}
+			if err := cfg.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLogAndReturnConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     LogAndReturnConfig
+		wantErr bool
+	}{
+		{name: "defaults", cfg: LogAndReturnConfig{}, wantErr: false},
+		{name: "function", cfg: LogAndReturnConfig{ExemptFunctions: []string{"example.com/app/cmd.run"}}, wantErr: false},
+		{name: "method", cfg: LogAndReturnConfig{ExemptFunctions: []string{"(*example.com/app/api.Server).handle"}}, wantErr: false},
+		{name: "packages", cfg: LogAndReturnConfig{ExemptPackages: []string{"example.com/app/cmd/..."}}, wantErr: false},
+		{name: "unqualified function", cfg: LogAndReturnConfig{ExemptFunctions: []string{"run"}}, wantErr: true},
+		{name: "invalid package pattern", cfg: LogAndReturnConfig{ExemptPackages: []string{"example.com/["}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{LogAndReturn: tt.cfg}
+			if err := cfg.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
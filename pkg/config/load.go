@@ -0,0 +1,102 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/mitchellh/mapstructure"
+	"gopkg.in/yaml.v3"
+)
+
+// FileNames lists the config file names searched for by Find, in order of preference.
+var FileNames = []string{".loglinter.yml", ".loglinter.yaml", ".loglinter.json", ".loglinter.toml"}
+
+// Decode decodes raw settings (e.g. the golangci-lint plugin settings or a
+// parsed config file) into a Config and validates it.
+func Decode(raw any) (*Config, error) {
+	var cfg Config
+	if err := mapstructure.Decode(raw, &cfg); err != nil {
+		return nil, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// Load reads a YAML, JSON or TOML config file, chosen by its extension, and
+// decodes it with Decode.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw map[string]any
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yml", ".yaml":
+		err = yaml.Unmarshal(data, &raw)
+	case ".json":
+		err = json.Unmarshal(data, &raw)
+	case ".toml":
+		err = toml.Unmarshal(data, &raw)
+	default:
+		return nil, fmt.Errorf("config %s: unsupported file extension %q (use .yml, .yaml, .json or .toml)", path, ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("config %s: %w", path, err)
+	}
+
+	cfg, err := Decode(raw)
+	if err != nil {
+		return nil, fmt.Errorf("config %s: %w", path, err)
+	}
+	// Relative baseline and dictionary paths are relative to the config file.
+	dir := filepath.Dir(path)
+	cfg.Baseline = resolvePath(dir, cfg.Baseline)
+	for i, dict := range cfg.Spelling.Dictionaries {
+		cfg.Spelling.Dictionaries[i] = resolvePath(dir, dict)
+	}
+	return cfg, nil
+}
+
+func resolvePath(dir, path string) string {
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(dir, path)
+}
+
+// Find looks for one of FileNames in dir and its parent directories and
+// returns the path of the first match, or "" if there is none.
+func Find(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		for _, name := range FileNames {
+			path := filepath.Join(dir, name)
+			info, err := os.Stat(path)
+			if err == nil && !info.IsDir() {
+				return path, nil
+			}
+			if err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return "", err
+			}
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
+		}
+		dir = parent
+	}
+}
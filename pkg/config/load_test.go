@@ -0,0 +1,196 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		wantErr bool
+	}{
+		{
+			name: "yaml",
+			file: ".loglinter.yml",
+			content: `
+rules:
+  english:
+    enabled: false
+  sensitive:
+    severity: warning
+symbols:
+  allowed: "!"
+sensitive:
+  keywords: ["pin"]
+loggers:
+  - package: my/log
+    user_type: generic
+    methods:
+      - name: Info
+        args_index: 1
+`,
+		},
+		{
+			name: "json",
+			file: ".loglinter.json",
+			content: `{
+  "rules": {"english": {"enabled": false}, "sensitive": {"severity": "warning"}},
+  "symbols": {"allowed": "!"},
+  "sensitive": {"keywords": ["pin"]},
+  "loggers": [{"package": "my/log", "user_type": "generic", "methods": [{"name": "Info", "args_index": 1}]}]
+}`,
+		},
+		{
+			name: "toml",
+			file: ".loglinter.toml",
+			content: `
+[rules.english]
+enabled = false
+
+[rules.sensitive]
+severity = "warning"
+
+[symbols]
+allowed = "!"
+
+[sensitive]
+keywords = ["pin"]
+
+[[loggers]]
+package = "my/log"
+user_type = "generic"
+
+[[loggers.methods]]
+name = "Info"
+args_index = 1
+`,
+		},
+		{name: "invalid syntax", file: "bad.yml", content: "rules: [", wantErr: true},
+		{name: "invalid setting", file: "bad.json", content: `{"rules": {"english": {"severity": "fatal"}}}`, wantErr: true},
+		{name: "unsupported extension", file: "loglinter.ini", content: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.file)
+			writeFile(t, path, tt.content)
+
+			cfg, err := Load(path)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Load() succeeded, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+
+			if cfg.IsRuleEnabled("english", true) {
+				t.Error("english rule should be disabled")
+			}
+			if got := cfg.RuleSeverity("sensitive"); got != SeverityWarning {
+				t.Errorf("sensitive severity = %q, want %q", got, SeverityWarning)
+			}
+			if cfg.Symbols.Allowed != "!" {
+				t.Errorf("symbols.allowed = %q, want %q", cfg.Symbols.Allowed, "!")
+			}
+			if len(cfg.Sensitive.Keywords) != 1 || cfg.Sensitive.Keywords[0] != "pin" {
+				t.Errorf("sensitive.keywords = %v, want [pin]", cfg.Sensitive.Keywords)
+			}
+			if len(cfg.Loggers) != 1 || len(cfg.Loggers[0].Methods) != 1 {
+				t.Fatalf("loggers = %+v, want one logger with one method", cfg.Loggers)
+			}
+			if idx := cfg.Loggers[0].Methods[0].ArgsIndex; idx == nil || *idx != 1 {
+				t.Errorf("args_index = %v, want 1", idx)
+			}
+		})
+	}
+}
+
+func TestDecode_English(t *testing.T) {
+	cfg, err := Decode(map[string]any{
+		"english": map[string]any{
+			"words": []any{"café"},
+			"overrides": []any{
+				map[string]any{"packages": []any{"example.com/app/i18n/..."}, "language": "ru"},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cfg.English.Words) != 1 || cfg.English.Words[0] != "café" {
+		t.Errorf("english.words = %v, want [café]", cfg.English.Words)
+	}
+	if len(cfg.English.Overrides) != 1 || cfg.English.Overrides[0].Language != "ru" {
+		t.Fatalf("english.overrides = %+v, want one override with language ru", cfg.English.Overrides)
+	}
+	if got := cfg.English.Overrides[0].Packages; len(got) != 1 || got[0] != "example.com/app/i18n/..." {
+		t.Errorf("override packages = %v", got)
+	}
+}
+
+func TestFind(t *testing.T) {
+	root := t.TempDir()
+	nested := filepath.Join(root, "a", "b")
+	if err := os.MkdirAll(nested, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := Find(nested)
+	if err != nil || got != "" {
+		t.Fatalf("Find() without config = %q, %v; want \"\", nil", got, err)
+	}
+
+	want := filepath.Join(root, ".loglinter.toml")
+	writeFile(t, want, "")
+	if got, err = Find(nested); err != nil || got != want {
+		t.Errorf("Find() = %q, %v; want %q", got, err, want)
+	}
+
+	// A closer file wins, and .yml is preferred over .json in the same directory.
+	want = filepath.Join(root, "a", ".loglinter.yml")
+	writeFile(t, want, "")
+	writeFile(t, filepath.Join(root, "a", ".loglinter.json"), "{}")
+	if got, err = Find(nested); err != nil || got != want {
+		t.Errorf("Find() = %q, %v; want %q", got, err, want)
+	}
+}
+
+func TestLoad_RelativePaths(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config", ".loglinter.yml")
+	abs := filepath.Join(dir, "abs.txt")
+	writeFile(t, path, `
+baseline: baseline.json
+spelling:
+  dictionaries: ["words.txt", "`+filepath.ToSlash(abs)+`"]
+`)
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := filepath.Join(dir, "config", "baseline.json"); cfg.Baseline != want {
+		t.Errorf("baseline = %q, want %q", cfg.Baseline, want)
+	}
+	want := []string{filepath.Join(dir, "config", "words.txt"), abs}
+	if got := cfg.Spelling.Dictionaries; len(got) != 2 || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("spelling.dictionaries = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,292 @@
+// Package errflow defines an analyzer that follows error values through the
+// control flow of each function, using its SSA form, so that log calls can
+// be checked for errors that are also returned on the same path, e.g.
+//
+//	if err != nil {
+//		slog.Error("failed", "error", err)
+//		return fmt.Errorf("loading user: %w", err)
+//	}
+//
+// The analysis is intra-procedural: an error is followed through
+// assignments, conversions and the values it is wrapped in (calls returning
+// an error, such as fmt.Errorf or errors.Join, and composite literals), but
+// not into the functions it is passed to.
+package errflow
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"reflect"
+	"sync"
+
+	"github.com/AlexanderGhosty/log-linter/pkg/ssautil"
+	"github.com/AlexanderGhosty/log-linter/pkg/utils"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ssa"
+)
+
+// Result answers whether the errors passed to a call are returned after it.
+// It is computed on first use, so that packages without error-level log
+// calls do not pay for building their SSA form.
+type Result struct {
+	flow func() (*flow, error)
+}
+
+// flow holds the SSA form of the analyzed functions.
+type flow struct {
+	// calls maps the opening parenthesis of each call to its instruction.
+	calls map[token.Pos]site
+	// values maps expressions to the SSA values they evaluate to.
+	values map[ast.Expr]ssa.Value
+}
+
+// site is the position of an instruction in its block.
+type site struct {
+	block *ssa.BasicBlock
+	index int
+}
+
+// Returned returns the position of a return statement that returns err, an
+// argument (or part of an argument) of call, or an error wrapping it, on a
+// path from the call. It returns false if there is none, or if the call is in
+// an exempt function.
+func (r *Result) Returned(call *ast.CallExpr, err ast.Expr) (token.Pos, bool) {
+	if r == nil {
+		return token.NoPos, false
+	}
+	f, _ := r.flow()
+	if f == nil {
+		return token.NoPos, false
+	}
+
+	s, ok := f.calls[call.Lparen]
+	if !ok {
+		return token.NoPos, false
+	}
+	v, ok := f.values[ast.Unparen(err)]
+	if !ok {
+		return token.NoPos, false
+	}
+	return returned(s, v)
+}
+
+// Err returns the error the SSA form of the package failed to build with, if
+// any (see ssautil.SSA.SrcFuncs). No error is then returned after a call.
+func (r *Result) Err() error {
+	if r == nil {
+		return nil
+	}
+	_, err := r.flow()
+	return err
+}
+
+// New returns an errflow analyzer. Calls in the functions named in exempt,
+// by types.Func.FullName (e.g. "(*example.com/app.Server).Handle"), in the
+// function literals they contain, and in the packages matching one of the
+// package patterns (see utils.MatchPackage) are not followed.
+func New(exempt, packages []string) *analysis.Analyzer {
+	t := &tracker{exempt: make(map[string]bool, len(exempt)), packages: packages}
+	for _, fn := range exempt {
+		t.exempt[fn] = true
+	}
+
+	return &analysis.Analyzer{
+		Name:       "loglintererrflow",
+		Doc:        "follows errors through the control flow for the loglinter analyzer",
+		Run:        t.run,
+		Requires:   []*analysis.Analyzer{ssautil.Analyzer},
+		ResultType: reflect.TypeOf((*Result)(nil)),
+	}
+}
+
+type tracker struct {
+	exempt   map[string]bool
+	packages []string
+}
+
+func (t *tracker) run(pass *analysis.Pass) (any, error) {
+	for _, pattern := range t.packages {
+		if utils.MatchPackage(pattern, pass.Pkg.Path()) {
+			return &Result{flow: func() (*flow, error) { return &flow{}, nil }}, nil
+		}
+	}
+
+	ssaResult := pass.ResultOf[ssautil.Analyzer].(*ssautil.SSA)
+	return &Result{flow: sync.OnceValues(func() (*flow, error) {
+		funcs, err := ssaResult.SrcFuncs()
+		if err != nil {
+			return nil, err
+		}
+		f := &flow{
+			calls:  make(map[token.Pos]site),
+			values: make(map[ast.Expr]ssa.Value),
+		}
+		for _, fn := range funcs {
+			if t.isExempt(fn) {
+				continue
+			}
+			for _, b := range fn.Blocks {
+				for i, instr := range b.Instrs {
+					switch instr := instr.(type) {
+					case *ssa.Call:
+						f.calls[instr.Pos()] = site{block: b, index: i}
+					case *ssa.DebugRef:
+						if !instr.IsAddr {
+							f.values[ast.Unparen(instr.Expr)] = instr.X
+						}
+					}
+				}
+			}
+		}
+		return f, nil
+	})}, nil
+}
+
+// isExempt reports whether fn, or the function declaring it, is exempt.
+func (t *tracker) isExempt(fn *ssa.Function) bool {
+	for fn.Parent() != nil {
+		fn = fn.Parent()
+	}
+	obj, ok := fn.Object().(*types.Func)
+	return ok && t.exempt[obj.Origin().FullName()]
+}
+
+// returned follows v from the instruction at s to the return statements
+// reachable from it, and returns the position of the first one that returns
+// v or a value derived from it.
+//
+// The values derived from v (carriers) are computed along the paths from s:
+// a φ-node carries v only if it takes a carrier from the block it is entered
+// from, so that an error replaced on the logging path, as in
+//
+//	if err != nil {
+//		log(err)
+//		err = nil
+//	}
+//	return err
+//
+// is not reported.
+func returned(s site, v ssa.Value) (token.Pos, bool) {
+	type item struct {
+		block    *ssa.BasicBlock
+		carriers map[ssa.Value]bool
+		start    int
+	}
+	in := make(map[*ssa.BasicBlock]map[ssa.Value]bool)
+	work := []item{{block: s.block, start: s.index + 1, carriers: map[ssa.Value]bool{v: true}}}
+
+	for len(work) > 0 {
+		it := work[0]
+		work = work[1:]
+
+		carriers := make(map[ssa.Value]bool, len(it.carriers))
+		for c := range it.carriers {
+			carriers[c] = true
+		}
+		for _, instr := range it.block.Instrs[it.start:] {
+			switch instr := instr.(type) {
+			case *ssa.Return:
+				for _, res := range instr.Results {
+					if carriers[res] {
+						return instr.Pos(), true
+					}
+				}
+			case *ssa.Store:
+				if alloc := ssautil.AllocOf(instr.Addr); alloc != nil && carriers[instr.Val] {
+					carriers[alloc] = true
+				}
+			case ssa.Value:
+				if carries(instr, carriers) {
+					carriers[instr] = true
+				}
+			}
+		}
+
+		for _, succ := range it.block.Succs {
+			next, changed := in[succ], false
+			if next == nil {
+				next, changed = make(map[ssa.Value]bool), true
+				in[succ] = next
+			}
+			for c := range carriers {
+				if !next[c] {
+					next[c], changed = true, true
+				}
+			}
+			edge := predIndex(succ, it.block)
+			for _, instr := range succ.Instrs {
+				phi, ok := instr.(*ssa.Phi)
+				if !ok {
+					break
+				}
+				if carriers[phi.Edges[edge]] && !next[phi] {
+					next[phi], changed = true, true
+				}
+			}
+			if changed {
+				work = append(work, item{block: succ, carriers: next})
+			}
+		}
+	}
+	return token.NoPos, false
+}
+
+// carries reports whether v holds one of the carriers: it converts or loads
+// one, or wraps one in a new error.
+func carries(v ssa.Value, carriers map[ssa.Value]bool) bool {
+	switch v := v.(type) {
+	case *ssa.MakeInterface:
+		return carriers[v.X]
+	case *ssa.ChangeInterface:
+		return carriers[v.X]
+	case *ssa.ChangeType:
+		return carriers[v.X]
+	case *ssa.TypeAssert:
+		return carriers[v.X]
+	case *ssa.Extract:
+		return carriers[v.Tuple]
+	case *ssa.Slice:
+		return carriers[v.X]
+	case *ssa.UnOp:
+		return v.Op == token.MUL && carriers[v.X]
+	case *ssa.Call:
+		if !wraps(v) {
+			return false
+		}
+		for _, arg := range v.Call.Args {
+			if carriers[arg] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// wraps reports whether the result of a call holds its arguments: the call
+// returns an error (as fmt.Errorf or errors.Join do), or appends to a slice.
+func wraps(call *ssa.Call) bool {
+	if b, ok := call.Call.Value.(*ssa.Builtin); ok {
+		return b.Name() == "append"
+	}
+	t := call.Type()
+	if tuple, ok := t.(*types.Tuple); ok {
+		for i := 0; i < tuple.Len(); i++ {
+			if ssautil.IsError(tuple.At(i).Type()) {
+				return true
+			}
+		}
+		return false
+	}
+	return ssautil.IsError(t)
+}
+
+// predIndex returns the index of pred among the predecessors of b.
+func predIndex(b, pred *ssa.BasicBlock) int {
+	for i, p := range b.Preds {
+		if p == pred {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,59 @@
+package errflow_test
+
+import (
+	"go/ast"
+	"go/types"
+	"path/filepath"
+	"testing"
+
+	"github.com/AlexanderGhosty/log-linter/pkg/errflow"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+// TestErrflow reports every error argument of calls to sink that is
+// returned after the call.
+func TestErrflow(t *testing.T) {
+	testdata, err := filepath.Abs("../../testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	errorType := types.Universe.Lookup("error").Type()
+	flowAnalyzer := errflow.New([]string{"errflow.Exempt", "errflow.ExemptClosure"}, []string{"errflow/exempt"})
+	sinkAnalyzer := &analysis.Analyzer{
+		Name:     "sink",
+		Doc:      "reports returned error arguments of sink calls",
+		Requires: []*analysis.Analyzer{flowAnalyzer},
+		Run: func(pass *analysis.Pass) (any, error) {
+			res := pass.ResultOf[flowAnalyzer].(*errflow.Result)
+			for _, file := range pass.Files {
+				ast.Inspect(file, func(n ast.Node) bool {
+					call, ok := n.(*ast.CallExpr)
+					if !ok {
+						return true
+					}
+					if ident, ok := call.Fun.(*ast.Ident); !ok || ident.Name != "sink" {
+						return true
+					}
+					for _, arg := range call.Args {
+						if sel, ok := arg.(*ast.CallExpr); ok {
+							// err.Error()
+							arg = sel.Fun.(*ast.SelectorExpr).X
+						}
+						if !types.Identical(pass.TypesInfo.TypeOf(arg), errorType) {
+							continue
+						}
+						if _, ok := res.Returned(call, arg); ok {
+							pass.Reportf(arg.Pos(), "returned")
+						}
+					}
+					return true
+				})
+			}
+			return nil, nil
+		},
+	}
+
+	analysistest.Run(t, testdata, sinkAnalyzer, "errflow", "errflow/exempt")
+}
@@ -0,0 +1,177 @@
+package logsupport
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// Call describes a log call resolved against the registry.
+type Call struct {
+	// Package and Func identify the logger function (e.g. "log/slog", "InfoContext").
+	// For a detected wrapper they describe the logger call the wrapper forwards to.
+	Package string
+	Func    string
+	// UserType is the user type of the matching logger configuration.
+	UserType string
+	// MessageIndex is the index of the message argument.
+	MessageIndex int
+	// ArgsIndex is the index of the first argument consumed after the message
+	// (key-value pairs or format operands), or -1 if there is none.
+	ArgsIndex int
+	// KeyValues is true if the arguments from ArgsIndex on are key-value pairs.
+	KeyValues bool
+	// Printf is true if the message is a printf-style format string.
+	Printf bool
+	// Print is true if every argument is formatted into the message (fmt.Print style).
+	Print bool
+}
+
+// WrapperFact marks a function that forwards one of its parameters to the
+// message of a supported log call, and optionally its variadic parameter to
+// the call's key-value arguments, e.g.
+//
+//	func logErr(ctx context.Context, msg string, args ...any) {
+//		slog.ErrorContext(ctx, msg, args...)
+//	}
+//
+// Calls to such functions are checked like calls to the underlying logger.
+type WrapperFact struct {
+	// Package and Func identify the logger function the wrapper forwards to.
+	Package string
+	Func    string
+	// MessageIndex is the index of the wrapper parameter used as the message.
+	MessageIndex int
+	// ArgsIndex is the index of the wrapper's variadic parameter forwarded as
+	// the remaining arguments of the log call, or -1.
+	ArgsIndex int
+	// KeyValues is true if the forwarded arguments are key-value pairs.
+	KeyValues bool
+}
+
+// AFact implements analysis.Fact.
+func (*WrapperFact) AFact() {}
+
+// String implements fmt.Stringer.
+func (f *WrapperFact) String() string {
+	return fmt.Sprintf("logwrapper(%s.%s, msg=%d, args=%d)", f.Package, f.Func, f.MessageIndex, f.ArgsIndex)
+}
+
+// Resolve returns the description of a supported log call, or false if the
+// call is not a log call. Calls to functions carrying a WrapperFact are
+// resolved through the fact; the analyzer running the pass must declare
+// WrapperFact in its FactTypes for this.
+func (r *Registry) Resolve(pass *analysis.Pass, call *ast.CallExpr) (Call, bool) {
+	if fact, ok := r.wrapperFact(pass, call); ok {
+		if !r.IsSupportedLogger(fact.Package, fact.Func) {
+			return Call{}, false
+		}
+		return Call{
+			Package:      fact.Package,
+			Func:         fact.Func,
+			UserType:     r.userType(fact.Package),
+			MessageIndex: fact.MessageIndex,
+			ArgsIndex:    fact.ArgsIndex,
+			KeyValues:    fact.KeyValues,
+			Printf:       r.IsPrintf(fact.Package, fact.Func),
+			Print:        r.IsPrint(fact.Package, fact.Func),
+		}, true
+	}
+
+	pkgPath, funcName, ok := r.ResolveCall(pass, call)
+	if !ok || !r.IsSupportedLogger(pkgPath, funcName) {
+		return Call{}, false
+	}
+
+	pkgPath = normalizeVendor(pkgPath)
+	userType := r.userType(pkgPath)
+	// Package functions named like zap's log methods, such as zap.Error,
+	// may be field constructors.
+	if userType == "zap" && receiverName(pass, call) == "" && r.IsFieldConstructor(pkgPath, funcName) {
+		return Call{}, false
+	}
+	msgIndex := r.MessageIndex(pkgPath, funcName)
+	lc := Call{
+		Package:      pkgPath,
+		Func:         funcName,
+		UserType:     userType,
+		MessageIndex: msgIndex,
+		ArgsIndex:    msgIndex + 1,
+		// slog and zap's sugared "w" methods take key-value pairs
+		KeyValues: userType == "slog" || (userType == "zap" && strings.HasSuffix(funcName, "w")),
+		Printf:    r.IsPrintf(pkgPath, funcName),
+		Print:     r.IsPrint(pkgPath, funcName),
+	}
+
+	// Generic loggers with an explicit method list are matched by receiver too.
+	if len(r.methods[pkgPath]) > 0 {
+		m := r.lookupMethod(pkgPath, funcName, receiverName(pass, call), false)
+		if m == nil {
+			return Call{}, false
+		}
+		// The index found by MessageIndex may be that of a method of
+		// another receiver with the same name.
+		lc.MessageIndex = r.loggerMessageIndex(pkgPath)
+		if m.cfg.MessageIndex != nil {
+			lc.MessageIndex = *m.cfg.MessageIndex
+		}
+		lc.Printf = m.cfg.Printf
+		lc.ArgsIndex = lc.MessageIndex + 1
+		if m.cfg.ArgsIndex != nil {
+			lc.ArgsIndex = *m.cfg.ArgsIndex
+			lc.KeyValues = true
+		}
+	}
+
+	return lc, true
+}
+
+// receiverName returns the name of the named receiver type of a method call,
+// or "" for package-level functions.
+func receiverName(pass *analysis.Pass, call *ast.CallExpr) string {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return ""
+	}
+	selection := pass.TypesInfo.Selections[sel]
+	if selection == nil {
+		return ""
+	}
+	if named, ok := types.Unalias(deref(selection.Recv())).(*types.Named); ok {
+		return named.Obj().Name()
+	}
+	return ""
+}
+
+// wrapperFact returns the WrapperFact of the called function, if any.
+func (r *Registry) wrapperFact(pass *analysis.Pass, call *ast.CallExpr) (*WrapperFact, bool) {
+	if pass.ImportObjectFact == nil {
+		return nil, false
+	}
+
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok {
+		return nil, false
+	}
+
+	fact := new(WrapperFact)
+	if !pass.ImportObjectFact(fn.Origin(), fact) {
+		return nil, false
+	}
+	return fact, true
+}
+
+// userType returns the user type configured for the package.
+func (r *Registry) userType(pkgPath string) string {
+	pkgPath = normalizeVendor(pkgPath)
+	for _, cfg := range r.configs {
+		if cfg.Package == pkgPath {
+			return cfg.UserType
+		}
+	}
+	return ""
+}
@@ -0,0 +1,109 @@
+package logsupport
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// ArgKind classifies an argument of a key-value log call.
+type ArgKind int
+
+const (
+	// ArgKey is a key followed by its value.
+	ArgKey ArgKind = iota
+	// ArgValue is the value of the preceding key.
+	ArgValue
+	// ArgAttr is a standalone attribute: a slog.Attr, or a zap.Field for zap.
+	ArgAttr
+	// ArgBadKey is an argument in key position that is neither a string nor
+	// an attribute. slog logs it as the value of a "!BADKEY" key; zap drops
+	// it together with the following value.
+	ArgBadKey
+	// ArgMissingValue is a key without a value at the end of the arguments.
+	ArgMissingValue
+	// ArgUnknown is an argument that cannot be paired, e.g. a slice passed
+	// with "...".
+	ArgUnknown
+)
+
+// KeyValueArg is an argument of a key-value log call and its role.
+type KeyValueArg struct {
+	Expr ast.Expr
+	Kind ArgKind
+}
+
+// PairKeyValues pairs the key-value arguments of a log call (the arguments
+// from lc.ArgsIndex on) the way the logger does at run time, using their
+// types: slog.Attr arguments (zap.Field for zap's sugared logger) stand
+// alone and other arguments in key position start a pair. Arguments of
+// interface type are assumed to hold strings in key position.
+func PairKeyValues(pass *analysis.Pass, lc Call, call *ast.CallExpr) []KeyValueArg {
+	if !lc.KeyValues || lc.ArgsIndex < 0 || lc.ArgsIndex >= len(call.Args) {
+		return nil
+	}
+	args := call.Args[lc.ArgsIndex:]
+	if call.Ellipsis.IsValid() {
+		return []KeyValueArg{{Expr: args[0], Kind: ArgUnknown}}
+	}
+
+	isZap := lc.UserType == "zap"
+	var pairs []KeyValueArg
+	for i := 0; i < len(args); {
+		arg := args[i]
+		t := pass.TypesInfo.TypeOf(arg)
+		switch {
+		case IsAttrType(t, lc.UserType):
+			pairs = append(pairs, KeyValueArg{Expr: arg, Kind: ArgAttr})
+			i++
+			continue
+		case i == len(args)-1 && (isZap || isKeyType(t)):
+			// zap drops any trailing argument; slog logs a trailing string
+			// as the value of "!BADKEY".
+			pairs = append(pairs, KeyValueArg{Expr: arg, Kind: ArgMissingValue})
+			i++
+			continue
+		case !isKeyType(t):
+			pairs = append(pairs, KeyValueArg{Expr: arg, Kind: ArgBadKey})
+			i++
+			if isZap {
+				pairs = append(pairs, KeyValueArg{Expr: args[i], Kind: ArgValue})
+				i++
+			}
+			continue
+		}
+		pairs = append(pairs,
+			KeyValueArg{Expr: arg, Kind: ArgKey},
+			KeyValueArg{Expr: args[i+1], Kind: ArgValue},
+		)
+		i += 2
+	}
+	return pairs
+}
+
+// IsAttrType reports whether t is the standalone attribute type of the
+// logger's user type: slog.Attr, or zap.Field for zap.
+func IsAttrType(t types.Type, userType string) bool {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+	pkgPath, name := normalizeVendor(named.Obj().Pkg().Path()), named.Obj().Name()
+	if userType == "zap" {
+		return name == "Field" && (pkgPath == "go.uber.org/zap" || pkgPath == "go.uber.org/zap/zapcore")
+	}
+	return name == "Attr" && pkgPath == "log/slog"
+}
+
+// isKeyType reports whether a value of type t can be a key: a string, or an
+// interface that may hold one.
+func isKeyType(t types.Type) bool {
+	if t == nil {
+		return true
+	}
+	if basic, ok := types.Unalias(t).(*types.Basic); ok {
+		return basic.Info()&types.IsString != 0
+	}
+	return types.IsInterface(t)
+}
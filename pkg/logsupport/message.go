@@ -0,0 +1,24 @@
+package logsupport
+
+import (
+	"go/ast"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// MessageFunc returns the qualified name of the function a call builds a
+// message or error text with: "fmt.Sprintf", "fmt.Sprint", "fmt.Sprintln",
+// "fmt.Errorf" or "errors.New". It returns "" for other calls and for calls
+// without arguments.
+func MessageFunc(pass *analysis.Pass, call *ast.CallExpr) string {
+	fn := typeutil.StaticCallee(pass.TypesInfo, call)
+	if fn == nil || fn.Pkg() == nil || len(call.Args) == 0 {
+		return ""
+	}
+	switch name := fn.Pkg().Path() + "." + fn.Name(); name {
+	case "fmt.Sprintf", "fmt.Sprint", "fmt.Sprintln", "fmt.Errorf", "errors.New":
+		return name
+	}
+	return ""
+}
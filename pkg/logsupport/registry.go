@@ -2,7 +2,10 @@ package logsupport
 
 import (
 	"go/ast"
+	"go/types"
+	"regexp"
 	"strings"
+	"sync"
 
 	"github.com/AlexanderGhosty/log-linter/pkg/config"
 	"github.com/AlexanderGhosty/log-linter/pkg/utils"
@@ -11,17 +14,52 @@ import (
 
 // Registry holds the configuration for supported loggers.
 type Registry struct {
+	// typeCache caches lookupType results, keyed by typeKey.
+	typeCache sync.Map
+	// methods holds the compiled method lists of generic loggers, keyed by package.
+	methods map[string][]methodMatcher
 	configs []config.LoggerConfig
 }
 
+// methodMatcher is a compiled config.MethodConfig.
+type methodMatcher struct {
+	pattern *regexp.Regexp
+	cfg     config.MethodConfig
+}
+
+// matches reports whether the matcher covers the function. If anyReceiver is
+// false, the configured receiver must equal receiver ("" for package functions).
+func (m *methodMatcher) matches(funcName, receiver string, anyReceiver bool) bool {
+	if !anyReceiver && m.cfg.Receiver != "" && m.cfg.Receiver != receiver {
+		return false
+	}
+	if m.pattern != nil {
+		return m.pattern.MatchString(funcName)
+	}
+	return m.cfg.Name == funcName
+}
+
 // NewRegistry creates a new Registry with the given matching configurations.
 func NewRegistry(customConfigs []config.LoggerConfig) *Registry {
 	// If custom configurations are provided (non-nil), use them exclusively.
 	// This allows users to disable default loggers by providing an empty list,
 	// or to define exactly the set of loggers they want.
 	if customConfigs != nil {
+		methods := make(map[string][]methodMatcher)
+		for _, cfg := range customConfigs {
+			for _, m := range cfg.Methods {
+				matcher := methodMatcher{cfg: m}
+				if m.Pattern != "" {
+					// Patterns are expected to be pre-validated by config.Validate().
+					matcher.pattern = regexp.MustCompile("^(?:" + m.Pattern + ")$")
+				}
+				methods[cfg.Package] = append(methods[cfg.Package], matcher)
+			}
+		}
+
 		return &Registry{
 			configs: customConfigs,
+			methods: methods,
 		}
 	}
 
@@ -48,6 +86,28 @@ func NewRegistry(customConfigs []config.LoggerConfig) *Registry {
 				"Float32", "Complex64", "Complex128", "Uintptr",
 			},
 		},
+		{
+			Package:      "log",
+			UserType:     "std",
+			MessageIndex: 0,
+		},
+		{
+			Package:           "github.com/rs/zerolog",
+			UserType:          "zerolog",
+			MessageIndex:      0,
+			FieldConstructors: zerologFieldMethods,
+		},
+		{
+			Package:      "github.com/rs/zerolog/log",
+			UserType:     "zerolog",
+			MessageIndex: 0,
+		},
+		{
+			Package:           "github.com/sirupsen/logrus",
+			UserType:          "logrus",
+			MessageIndex:      0,
+			FieldConstructors: []string{"WithField", "WithFields"},
+		},
 	}
 
 	return &Registry{
@@ -55,6 +115,36 @@ func NewRegistry(customConfigs []config.LoggerConfig) *Registry {
 	}
 }
 
+// zerologFieldMethods are the *zerolog.Event methods whose first argument is a field key.
+var zerologFieldMethods = []string{
+	"Str", "Strs", "Stringer", "Stringers", "Bytes", "Hex", "RawJSON", "RawCBOR",
+	"Int", "Ints", "Int8", "Ints8", "Int16", "Ints16", "Int32", "Ints32", "Int64", "Ints64",
+	"Uint", "Uints", "Uint8", "Uints8", "Uint16", "Uints16", "Uint32", "Uints32", "Uint64", "Uints64",
+	"Float32", "Floats32", "Float64", "Floats64", "Bool", "Bools",
+	"Time", "Times", "Dur", "Durs", "TimeDiff", "Interface", "Any", "Dict", "Array", "Object",
+	"AnErr", "Errs", "IPAddr", "IPPrefix", "MACAddr", "Type", "Fields",
+}
+
+// logrusLevels are the level prefixes of logrus logging methods (Info, Infof, Infoln, ...).
+var logrusLevels = []string{"Trace", "Debug", "Info", "Print", "Warn", "Warning", "Error", "Fatal", "Panic", "Log"}
+
+// logrusMethod splits a logrus logging method name into its level and
+// suffix ("", "f" or "ln"). It returns ok=false for other names.
+func logrusMethod(funcName string) (level, suffix string, ok bool) {
+	for _, s := range []string{"", "f", "ln"} {
+		name := strings.TrimSuffix(funcName, s)
+		if s != "" && name == funcName {
+			continue
+		}
+		for _, l := range logrusLevels {
+			if name == l {
+				return name, s, true
+			}
+		}
+	}
+	return "", "", false
+}
+
 // IsSupportedLogger returns true if the package and function correspond to a supported logger.
 func (r *Registry) IsSupportedLogger(pkgPath, funcName string) bool {
 	// Remove vendor prefix for matching
@@ -82,9 +172,35 @@ func (r *Registry) IsSupportedLogger(pkgPath, funcName string) bool {
 				case "Infow", "Warnw", "Errorw", "Debugw", "Panicw", "Fatalw", "DPanicw":
 					return true
 				}
+			case "std":
+				switch funcName {
+				// Package-level functions and *log.Logger methods
+				case "Print", "Printf", "Println":
+					return true
+				case "Fatal", "Fatalf", "Fatalln", "Panic", "Panicf", "Panicln":
+					return true
+				case "Output":
+					return true
+				}
+			case "zerolog":
+				switch funcName {
+				// Terminal *zerolog.Event methods: log.Info().Str("k", v).Msg("message")
+				case "Msg", "Msgf", "Send":
+					return true
+				// zerolog.Logger and zerolog/log print methods
+				case "Print", "Printf":
+					return true
+				}
+			case "logrus":
+				// Package functions, *logrus.Logger and *logrus.Entry methods
+				_, _, ok := logrusMethod(funcName)
+				return ok
 			default:
-				// For generic loggers, we might need more specific configuration
-				// For now, assume if the package matches, it's supported
+				// Generic loggers either list their methods explicitly
+				// or treat every function in the package as a log call.
+				if len(r.methods[cfg.Package]) > 0 {
+					return r.lookupMethod(cfg.Package, funcName, "", true) != nil
+				}
 				return true
 			}
 		}
@@ -92,6 +208,59 @@ func (r *Registry) IsSupportedLogger(pkgPath, funcName string) bool {
 	return false
 }
 
+// IsPrintf returns true if the function takes a printf-style format string as its message.
+func (r *Registry) IsPrintf(pkgPath, funcName string) bool {
+	cleanPkgPath := normalizeVendor(pkgPath)
+
+	for _, cfg := range r.configs {
+		if cfg.Package == cleanPkgPath {
+			switch cfg.UserType {
+			case "zap":
+				// SugaredLogger printf-style methods (Infof, Errorf, ...)
+				return strings.HasSuffix(funcName, "f") && r.IsSupportedLogger(pkgPath, funcName)
+			case "std":
+				// log.Printf, log.Fatalf, log.Panicf
+				return strings.HasSuffix(funcName, "f") && r.IsSupportedLogger(pkgPath, funcName)
+			case "zerolog":
+				return funcName == "Msgf" || funcName == "Printf"
+			case "logrus":
+				_, suffix, ok := logrusMethod(funcName)
+				return ok && suffix == "f"
+			default:
+				m := r.lookupMethod(cfg.Package, funcName, "", true)
+				return m != nil && m.cfg.Printf
+			}
+		}
+	}
+	return false
+}
+
+// IsPrint returns true if the function formats every argument into the message
+// the way fmt.Print and fmt.Println do (e.g. log.Println("user", name)).
+func (r *Registry) IsPrint(pkgPath, funcName string) bool {
+	cleanPkgPath := normalizeVendor(pkgPath)
+
+	for _, cfg := range r.configs {
+		if cfg.Package == cleanPkgPath {
+			switch cfg.UserType {
+			case "std":
+				switch funcName {
+				case "Print", "Println", "Fatal", "Fatalln", "Panic", "Panicln":
+					return true
+				}
+			case "zerolog":
+				return funcName == "Print"
+			case "logrus":
+				// Info(args...) and Infoln(args...) are both Sprint-style
+				_, suffix, ok := logrusMethod(funcName)
+				return ok && suffix != "f"
+			}
+			return false
+		}
+	}
+	return false
+}
+
 // MessageIndex returns the index of the log message argument.
 func (r *Registry) MessageIndex(pkgPath, funcName string) int {
 	cleanPkgPath := normalizeVendor(pkgPath)
@@ -109,12 +278,52 @@ func (r *Registry) MessageIndex(pkgPath, funcName string) int {
 				}
 				return 0
 			}
+			if cfg.UserType == "std" {
+				// Output(calldepth int, s string)
+				if funcName == "Output" {
+					return 1
+				}
+				return 0
+			}
+			if cfg.UserType == "logrus" {
+				// Log(level, args...), Logf(level, format, args...)
+				if level, _, ok := logrusMethod(funcName); ok && level == "Log" {
+					return 1
+				}
+				return 0
+			}
+			if m := r.lookupMethod(cfg.Package, funcName, "", true); m != nil && m.cfg.MessageIndex != nil {
+				return *m.cfg.MessageIndex
+			}
+			return cfg.MessageIndex
+		}
+	}
+	return 0
+}
+
+// loggerMessageIndex returns the message index configured for the logger
+// of the package, ignoring its methods.
+func (r *Registry) loggerMessageIndex(pkgPath string) int {
+	for _, cfg := range r.configs {
+		if cfg.Package == pkgPath {
 			return cfg.MessageIndex
 		}
 	}
 	return 0
 }
 
+// lookupMethod returns the first configured method of a generic logger
+// matching the function and receiver type name.
+func (r *Registry) lookupMethod(pkgPath, funcName, receiver string, anyReceiver bool) *methodMatcher {
+	matchers := r.methods[pkgPath]
+	for i := range matchers {
+		if matchers[i].matches(funcName, receiver, anyReceiver) {
+			return &matchers[i]
+		}
+	}
+	return nil
+}
+
 // IsFieldConstructor returns true if the function is a field constructor.
 func (r *Registry) IsFieldConstructor(pkgPath, funcName string) bool {
 	cleanPkgPath := normalizeVendor(pkgPath)
@@ -133,20 +342,18 @@ func (r *Registry) IsFieldConstructor(pkgPath, funcName string) bool {
 
 // InspectLogArgs iterates over the arguments of a log call.
 func (r *Registry) InspectLogArgs(pass *analysis.Pass, call *ast.CallExpr, msgIndex int, fn func(arg ast.Expr, isKey bool)) {
-	pkgPath, funcName, ok := utils.ResolveCallPackagePath(pass, call)
+	lc, ok := r.Resolve(pass, call)
 	if !ok {
 		return
 	}
 
-	// Determine UserType
-	cleanPkgPath := normalizeVendor(pkgPath)
+	if lc.UserType == "zerolog" || lc.UserType == "logrus" {
+		r.inspectChain(pass, call, fn)
+	}
 
-	var userType string
-	for _, cfg := range r.configs {
-		if cfg.Package == cleanPkgPath {
-			userType = cfg.UserType
-			break
-		}
+	kinds := make(map[ast.Expr]ArgKind)
+	for _, kv := range PairKeyValues(pass, lc, call) {
+		kinds[kv.Expr] = kv.Kind
 	}
 
 	for i, arg := range call.Args {
@@ -174,21 +381,75 @@ func (r *Registry) InspectLogArgs(pass *analysis.Pass, call *ast.CallExpr, msgIn
 			}
 		}
 
-		// Handle key-value pairs
-		isSlog := userType == "slog"
-		// For zap, only "w" suffixed methods are key-value pairs (sugared)
-		isZapSugared := userType == "zap" && strings.HasSuffix(funcName, "w")
+		// Handle key-value pairs, paired by type (see PairKeyValues)
+		if kind, ok := kinds[arg]; ok {
+			switch kind {
+			case ArgKey, ArgMissingValue, ArgUnknown:
+				// The first element of a slice passed with "..." is a key.
+				fn(arg, true)
+			default:
+				fn(arg, false)
+			}
+		}
+	}
+}
+
+// inspectChain walks a fluent builder chain such as
+// log.Info().Str("user", u).Int("attempt", n).Msg("login") or
+// logrus.WithField("user", u).Info("login") backwards from the terminal call
+// and reports the arguments of every field method, in source order.
+func (r *Registry) inspectChain(pass *analysis.Pass, call *ast.CallExpr, fn func(arg ast.Expr, isKey bool)) {
+	var fields []*ast.CallExpr
+
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	for ok {
+		inner, isCall := sel.X.(*ast.CallExpr)
+		if !isCall {
+			break
+		}
+
+		pkgPath, funcName, resolved := utils.ResolveCallPackagePath(pass, inner)
+		if resolved && r.IsFieldConstructor(pkgPath, funcName) {
+			fields = append(fields, inner)
+		}
+
+		sel, ok = inner.Fun.(*ast.SelectorExpr)
+	}
+
+	for i := len(fields) - 1; i >= 0; i-- {
+		args := fields[i].Args
+		if len(args) == 0 {
+			continue
+		}
 
-		if isSlog || isZapSugared {
-			relativeIndex := i - msgIndex
-			// In key-value pairs, odd indices (1, 3, ...) relative to message are keys
-			isKey := relativeIndex%2 == 1
+		// Map literal fields, e.g. WithFields(logrus.Fields{"user": u})
+		if lit, ok := args[0].(*ast.CompositeLit); ok && isMapLiteral(pass, lit) {
+			for _, elt := range lit.Elts {
+				if kv, ok := elt.(*ast.KeyValueExpr); ok {
+					fn(kv.Key, true)
+					fn(kv.Value, false)
+				}
+			}
+			continue
+		}
 
-			fn(arg, isKey)
+		fn(args[0], true)
+		for _, valArg := range args[1:] {
+			fn(valArg, false)
 		}
 	}
 }
 
+// isMapLiteral reports whether lit is a composite literal of map type.
+func isMapLiteral(pass *analysis.Pass, lit *ast.CompositeLit) bool {
+	tv, ok := pass.TypesInfo.Types[lit]
+	if !ok || tv.Type == nil {
+		return false
+	}
+	_, isMap := tv.Type.Underlying().(*types.Map)
+	return isMap
+}
+
 // normalizeVendor strips the vendor prefix from a package path if present.
 func normalizeVendor(pkgPath string) string {
 	if i := strings.Index(pkgPath, "/vendor/"); i >= 0 {
@@ -54,9 +54,40 @@ func TestIsSupportedLogger(t *testing.T) {
 		{"zap unsupported With", "go.uber.org/zap", "With", false},
 		{"zap unsupported Sync", "go.uber.org/zap", "Sync", false},
 		{"zap unsupported Sugar", "go.uber.org/zap", "Sugar", false},
+		// log
+		{"log Print", "log", "Print", true},
+		{"log Printf", "log", "Printf", true},
+		{"log Println", "log", "Println", true},
+		{"log Fatal", "log", "Fatal", true},
+		{"log Fatalf", "log", "Fatalf", true},
+		{"log Fatalln", "log", "Fatalln", true},
+		{"log Panic", "log", "Panic", true},
+		{"log Panicf", "log", "Panicf", true},
+		{"log Panicln", "log", "Panicln", true},
+		{"log Output", "log", "Output", true},
+		{"log unsupported SetFlags", "log", "SetFlags", false},
+		{"log unsupported New", "log", "New", false},
+		// zerolog
+		{"zerolog Msg", "github.com/rs/zerolog", "Msg", true},
+		{"zerolog Msgf", "github.com/rs/zerolog", "Msgf", true},
+		{"zerolog Send", "github.com/rs/zerolog", "Send", true},
+		{"zerolog Print", "github.com/rs/zerolog", "Print", true},
+		{"zerolog/log Printf", "github.com/rs/zerolog/log", "Printf", true},
+		{"zerolog unsupported Str", "github.com/rs/zerolog", "Str", false},
+		{"zerolog/log unsupported Info", "github.com/rs/zerolog/log", "Info", false},
+		// logrus
+		{"logrus Info", "github.com/sirupsen/logrus", "Info", true},
+		{"logrus Infof", "github.com/sirupsen/logrus", "Infof", true},
+		{"logrus Infoln", "github.com/sirupsen/logrus", "Infoln", true},
+		{"logrus Warningf", "github.com/sirupsen/logrus", "Warningf", true},
+		{"logrus Trace", "github.com/sirupsen/logrus", "Trace", true},
+		{"logrus Log", "github.com/sirupsen/logrus", "Log", true},
+		{"logrus Logf", "github.com/sirupsen/logrus", "Logf", true},
+		{"logrus unsupported WithField", "github.com/sirupsen/logrus", "WithField", false},
+		{"logrus unsupported SetLevel", "github.com/sirupsen/logrus", "SetLevel", false},
+		{"logrus unsupported Infow", "github.com/sirupsen/logrus", "Infow", false},
 		// other packages
 		{"fmt Println", "fmt", "Println", false},
-		{"log Printf", "log", "Printf", false},
 	}
 
 	for _, tt := range tests {
@@ -103,6 +134,12 @@ func TestIsFieldConstructor(t *testing.T) {
 		// zap non-constructors
 		{"zap Info logger", "go.uber.org/zap", "Info", false},
 		{"zap Named", "go.uber.org/zap", "Named", false},
+		// zerolog event field methods
+		{"zerolog Str", "github.com/rs/zerolog", "Str", true},
+		{"zerolog Int", "github.com/rs/zerolog", "Int", true},
+		{"zerolog Interface", "github.com/rs/zerolog", "Interface", true},
+		{"zerolog Err", "github.com/rs/zerolog", "Err", false},
+		{"zerolog Msg", "github.com/rs/zerolog", "Msg", false},
 		// other packages
 		{"fmt Sprintf", "fmt", "Sprintf", false},
 		{"strings Contains", "strings", "Contains", false},
@@ -146,6 +183,13 @@ func TestMessageIndex(t *testing.T) {
 		// zap: msg at index 0
 		{"zap Info", "go.uber.org/zap", "Info", 0},
 		{"zap Infof", "go.uber.org/zap", "Infof", 0},
+		// log: msg at index 0, except Output(calldepth, s)
+		{"log Print", "log", "Print", 0},
+		{"log Printf", "log", "Printf", 0},
+		{"log Output", "log", "Output", 1},
+		// logrus: msg at index 0, except Log*(level, ...)
+		{"logrus Info", "github.com/sirupsen/logrus", "Info", 0},
+		{"logrus Logf", "github.com/sirupsen/logrus", "Logf", 1},
 		// vendored
 		{"vendored slog InfoContext", "myproject/vendor/log/slog", "InfoContext", 1},
 		{"vendored slog LogAttrs", "myproject/vendor/log/slog", "LogAttrs", 2},
@@ -161,6 +205,72 @@ func TestMessageIndex(t *testing.T) {
 	}
 }
 
+func TestIsPrintf(t *testing.T) {
+	r := NewRegistry(nil)
+
+	tests := []struct {
+		name     string
+		pkgPath  string
+		funcName string
+		want     bool
+	}{
+		{"zap sugared Infof", "go.uber.org/zap", "Infof", true},
+		{"zap sugared Errorf", "go.uber.org/zap", "Errorf", true},
+		{"zap sugared Infow", "go.uber.org/zap", "Infow", false},
+		{"zap Info", "go.uber.org/zap", "Info", false},
+		{"slog Info", "log/slog", "Info", false},
+		{"log Printf", "log", "Printf", true},
+		{"log Fatalf", "log", "Fatalf", true},
+		{"log Println", "log", "Println", false},
+		{"zerolog Msgf", "github.com/rs/zerolog", "Msgf", true},
+		{"zerolog Msg", "github.com/rs/zerolog", "Msg", false},
+		{"logrus Infof", "github.com/sirupsen/logrus", "Infof", true},
+		{"logrus Info", "github.com/sirupsen/logrus", "Info", false},
+		{"logrus Infoln", "github.com/sirupsen/logrus", "Infoln", false},
+		{"unknown package", "fmt", "Printf", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := r.IsPrintf(tt.pkgPath, tt.funcName)
+			if got != tt.want {
+				t.Errorf("IsPrintf(%q, %q) = %v, want %v", tt.pkgPath, tt.funcName, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsPrint(t *testing.T) {
+	r := NewRegistry(nil)
+
+	tests := []struct {
+		name     string
+		pkgPath  string
+		funcName string
+		want     bool
+	}{
+		{"log Print", "log", "Print", true},
+		{"log Println", "log", "Println", true},
+		{"log Fatalln", "log", "Fatalln", true},
+		{"log Printf", "log", "Printf", false},
+		{"log Output", "log", "Output", false},
+		{"logrus Info", "github.com/sirupsen/logrus", "Info", true},
+		{"logrus Infoln", "github.com/sirupsen/logrus", "Infoln", true},
+		{"logrus Infof", "github.com/sirupsen/logrus", "Infof", false},
+		{"slog Info", "log/slog", "Info", false},
+		{"zap Info", "go.uber.org/zap", "Info", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := r.IsPrint(tt.pkgPath, tt.funcName)
+			if got != tt.want {
+				t.Errorf("IsPrint(%q, %q) = %v, want %v", tt.pkgPath, tt.funcName, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestNewRegistry_Replace(t *testing.T) {
 	// Create a custom config that replaces defaults
 	custom := []config.LoggerConfig{
@@ -276,3 +386,44 @@ func TestRegistry_ConfigParameters(t *testing.T) {
 		}
 	})
 }
+
+func TestRegistry_GenericMethods(t *testing.T) {
+	msgIndex := 1
+	r := NewRegistry([]config.LoggerConfig{{
+		Package:      "my/pkg",
+		UserType:     "generic",
+		MessageIndex: 0,
+		Methods: []config.MethodConfig{
+			{Name: "Info"},
+			{Pattern: "(Debug|Warn)f", MessageIndex: &msgIndex, Printf: true},
+		},
+	}})
+
+	tests := []struct {
+		name       string
+		funcName   string
+		supported  bool
+		printf     bool
+		messageIdx int
+	}{
+		{name: "exact name", funcName: "Info", supported: true, printf: false, messageIdx: 0},
+		{name: "pattern", funcName: "Debugf", supported: true, printf: true, messageIdx: 1},
+		{name: "pattern is anchored", funcName: "Debugfx", supported: false, printf: false, messageIdx: 0},
+		{name: "helper constructor", funcName: "NewLogger", supported: false, printf: false, messageIdx: 0},
+		{name: "helper setter", funcName: "SetLevel", supported: false, printf: false, messageIdx: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := r.IsSupportedLogger("my/pkg", tt.funcName); got != tt.supported {
+				t.Errorf("IsSupportedLogger(%q) = %v, want %v", tt.funcName, got, tt.supported)
+			}
+			if got := r.IsPrintf("my/pkg", tt.funcName); got != tt.printf {
+				t.Errorf("IsPrintf(%q) = %v, want %v", tt.funcName, got, tt.printf)
+			}
+			if got := r.MessageIndex("my/pkg", tt.funcName); got != tt.messageIdx {
+				t.Errorf("MessageIndex(%q) = %d, want %d", tt.funcName, got, tt.messageIdx)
+			}
+		})
+	}
+}
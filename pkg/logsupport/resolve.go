@@ -0,0 +1,187 @@
+package logsupport
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/AlexanderGhosty/log-linter/pkg/utils"
+	"golang.org/x/tools/go/analysis"
+)
+
+// ResolveCall resolves the logger package and function name of a call expression.
+//
+// Calls into a configured package are returned as is. Method calls on other
+// receivers are mapped onto a configured logger package when
+//   - the receiver's type is one of the logger's configured Types (or implements
+//     a configured interface type), or
+//   - the method has the same shape as a method of a built-in logger, e.g.
+//     Info(msg string, args ...any) on a user-defined interface or a wrapper
+//     struct is treated like slog's Info.
+//
+// Otherwise the result of utils.ResolveCallPackagePath is returned unchanged.
+func (r *Registry) ResolveCall(pass *analysis.Pass, call *ast.CallExpr) (string, string, bool) {
+	pkgPath, funcName, ok := utils.ResolveCallPackagePath(pass, call)
+	if !ok || r.hasPackage(pkgPath) {
+		return pkgPath, funcName, ok
+	}
+
+	sel, isSel := call.Fun.(*ast.SelectorExpr)
+	if !isSel {
+		return pkgPath, funcName, ok
+	}
+	selection := pass.TypesInfo.Selections[sel]
+	if selection == nil || selection.Kind() != types.MethodVal {
+		return pkgPath, funcName, ok
+	}
+	recv := selection.Recv()
+
+	for _, cfg := range r.configs {
+		for _, typeName := range cfg.Types {
+			if target := r.lookupType(pass.Pkg, typeName); target != nil && matchesType(recv, target) {
+				return cfg.Package, funcName, true
+			}
+		}
+	}
+
+	sig, isSig := selection.Obj().Type().(*types.Signature)
+	if !isSig {
+		return pkgPath, funcName, ok
+	}
+	for _, cfg := range r.configs {
+		if matchesShape(cfg.UserType, funcName, sig) {
+			return cfg.Package, funcName, true
+		}
+	}
+
+	return pkgPath, funcName, ok
+}
+
+// hasPackage reports whether the package is covered by a logger configuration.
+func (r *Registry) hasPackage(pkgPath string) bool {
+	pkgPath = normalizeVendor(pkgPath)
+	for _, cfg := range r.configs {
+		if cfg.Package == pkgPath {
+			return true
+		}
+	}
+	return false
+}
+
+// typeKey identifies a qualified type name as seen from a particular package.
+type typeKey struct {
+	from *types.Package
+	name string
+}
+
+// lookupType finds the named type "import/path.Name" among pkg and its transitive imports.
+func (r *Registry) lookupType(pkg *types.Package, qualified string) types.Type {
+	key := typeKey{from: pkg, name: qualified}
+	if cached, ok := r.typeCache.Load(key); ok {
+		t, _ := cached.(types.Type)
+		return t
+	}
+
+	var result types.Type
+	if dot := strings.LastIndex(qualified, "."); dot > 0 {
+		path, name := qualified[:dot], qualified[dot+1:]
+		if target := findPackage(pkg, path, make(map[*types.Package]bool)); target != nil {
+			if obj, ok := target.Scope().Lookup(name).(*types.TypeName); ok {
+				result = obj.Type()
+			}
+		}
+	}
+
+	r.typeCache.Store(key, result)
+	return result
+}
+
+// findPackage searches pkg and its transitive imports for the package with the given path.
+func findPackage(pkg *types.Package, path string, seen map[*types.Package]bool) *types.Package {
+	if pkg == nil || seen[pkg] {
+		return nil
+	}
+	seen[pkg] = true
+
+	if normalizeVendor(pkg.Path()) == path {
+		return pkg
+	}
+	for _, imp := range pkg.Imports() {
+		if found := findPackage(imp, path, seen); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// matchesType reports whether recv is the target type (ignoring pointers) or,
+// if target is an interface, implements it.
+func matchesType(recv, target types.Type) bool {
+	if iface, ok := target.Underlying().(*types.Interface); ok {
+		return types.Implements(recv, iface) || types.Implements(types.NewPointer(recv), iface)
+	}
+	return types.Identical(deref(recv), deref(target))
+}
+
+func deref(t types.Type) types.Type {
+	if ptr, ok := t.(*types.Pointer); ok {
+		return ptr.Elem()
+	}
+	return t
+}
+
+// matchesShape reports whether a method with the given name and signature looks
+// like a logging method of the given built-in user type.
+//
+// Only shapes that are unlikely to collide with unrelated APIs are recognised
+// (e.g. testing.T's Errorf is deliberately not treated as a printf logger).
+func matchesShape(userType, funcName string, sig *types.Signature) bool {
+	params := sig.Params()
+	if !sig.Variadic() || params.Len() == 0 {
+		return false
+	}
+	variadic := params.At(params.Len() - 1).Type().(*types.Slice).Elem()
+
+	switch userType {
+	case "slog":
+		switch funcName {
+		// Info(msg string, args ...any)
+		case "Info", "Warn", "Error", "Debug":
+			return params.Len() == 2 && isString(params.At(0).Type()) && isEmptyInterface(variadic)
+		// InfoContext(ctx context.Context, msg string, args ...any)
+		case "InfoContext", "WarnContext", "ErrorContext", "DebugContext":
+			return params.Len() == 3 && isNamed(params.At(0).Type(), "context", "Context") &&
+				isString(params.At(1).Type()) && isEmptyInterface(variadic)
+		}
+	case "zap":
+		switch funcName {
+		// Info(msg string, fields ...zap.Field)
+		case "Info", "Warn", "Error", "Debug", "Panic", "Fatal", "DPanic":
+			return params.Len() == 2 && isString(params.At(0).Type()) &&
+				(isNamed(variadic, "go.uber.org/zap", "Field") || isNamed(variadic, "go.uber.org/zap/zapcore", "Field"))
+		// Infow(msg string, keysAndValues ...interface{})
+		case "Infow", "Warnw", "Errorw", "Debugw", "Panicw", "Fatalw", "DPanicw":
+			return params.Len() == 2 && isString(params.At(0).Type()) && isEmptyInterface(variadic)
+		}
+	}
+	return false
+}
+
+func isString(t types.Type) bool {
+	basic, ok := t.Underlying().(*types.Basic)
+	return ok && basic.Kind() == types.String
+}
+
+func isEmptyInterface(t types.Type) bool {
+	iface, ok := t.Underlying().(*types.Interface)
+	return ok && iface.NumMethods() == 0
+}
+
+func isNamed(t types.Type, pkgPath, name string) bool {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Obj()
+	return obj.Pkg() != nil && normalizeVendor(obj.Pkg().Path()) == pkgPath && obj.Name() == name
+}
@@ -0,0 +1,58 @@
+package output
+
+import (
+	"encoding/xml"
+	"io"
+)
+
+type checkstyleOutput struct {
+	XMLName xml.Name          `xml:"checkstyle"`
+	Version string            `xml:"version,attr"`
+	Files   []*checkstyleFile `xml:"file"`
+}
+
+type checkstyleFile struct {
+	Name   string            `xml:"name,attr"`
+	Errors []checkstyleError `xml:"error"`
+}
+
+type checkstyleError struct {
+	Severity string `xml:"severity,attr"`
+	Message  string `xml:"message,attr"`
+	Source   string `xml:"source,attr"`
+	Line     int    `xml:"line,attr"`
+	Column   int    `xml:"column,attr"`
+}
+
+// writeCheckstyle prints the diagnostics as a Checkstyle XML report, grouped
+// by file in the order the files first appear.
+func writeCheckstyle(w io.Writer, diags []Diagnostic) error {
+	out := checkstyleOutput{Version: "5.0"}
+	files := make(map[string]*checkstyleFile)
+	for _, d := range diags {
+		file, ok := files[d.File]
+		if !ok {
+			file = &checkstyleFile{Name: d.File}
+			files[d.File] = file
+			out.Files = append(out.Files, file)
+		}
+		file.Errors = append(file.Errors, checkstyleError{
+			Severity: d.Severity,
+			Message:  d.Message,
+			Source:   toolName + "." + d.Rule,
+			Line:     d.Line,
+			Column:   d.Column,
+		})
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(out); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
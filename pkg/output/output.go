@@ -0,0 +1,180 @@
+// Package output renders loglinter diagnostics in machine-readable formats
+// for the standalone command.
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/token"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/AlexanderGhosty/log-linter/pkg/config"
+	"golang.org/x/tools/go/analysis"
+)
+
+// Supported output formats.
+const (
+	FormatText       = "text"
+	FormatJSON       = "json"
+	FormatSARIF      = "sarif"
+	FormatCheckstyle = "checkstyle"
+)
+
+// Formats lists the supported output formats.
+var Formats = []string{FormatText, FormatJSON, FormatSARIF, FormatCheckstyle}
+
+// Diagnostic is a diagnostic with resolved positions.
+type Diagnostic struct {
+	// Rule is the name of the rule that reported the diagnostic (e.g. "lowercase").
+	Rule string `json:"rule"`
+	// Severity is one of config.SeverityError, SeverityWarning or SeverityInfo.
+	Severity string `json:"severity"`
+	// File is the path of the file, relative to the working directory if possible.
+	File           string         `json:"file"`
+	Message        string         `json:"message"`
+	SuggestedFixes []SuggestedFix `json:"suggested_fixes,omitempty"`
+	Line           int            `json:"line"`
+	Column         int            `json:"column"`
+	EndLine        int            `json:"end_line,omitempty"`
+	EndColumn      int            `json:"end_column,omitempty"`
+}
+
+// SuggestedFix is a suggested fix with resolved positions.
+type SuggestedFix struct {
+	Message string     `json:"message"`
+	Edits   []TextEdit `json:"edits"`
+}
+
+// TextEdit replaces the text between two positions of File with NewText.
+type TextEdit struct {
+	File      string `json:"file"`
+	NewText   string `json:"new_text"`
+	Line      int    `json:"line"`
+	Column    int    `json:"column"`
+	EndLine   int    `json:"end_line"`
+	EndColumn int    `json:"end_column"`
+}
+
+// FromAnalysis converts a diagnostic reported by the loglinter analyzer.
+// The rule is taken from the diagnostic's Category, and the severity prefix
+// the analyzer adds to non-error messages is moved into Severity.
+// File names are made relative to dir if they are inside it.
+func FromAnalysis(fset *token.FileSet, d analysis.Diagnostic, cfg *config.Config, dir string) Diagnostic {
+	severity := cfg.RuleSeverity(d.Category)
+	message := d.Message
+	if severity != config.SeverityError {
+		message = strings.TrimPrefix(message, severity+": ")
+	}
+
+	start := fset.Position(d.Pos)
+	end := start
+	if d.End.IsValid() {
+		end = fset.Position(d.End)
+	}
+
+	out := Diagnostic{
+		Rule:      d.Category,
+		Severity:  severity,
+		File:      relPath(dir, start.Filename),
+		Message:   message,
+		Line:      start.Line,
+		Column:    start.Column,
+		EndLine:   end.Line,
+		EndColumn: end.Column,
+	}
+
+	for _, fix := range d.SuggestedFixes {
+		sf := SuggestedFix{Message: fix.Message}
+		for _, edit := range fix.TextEdits {
+			editStart := fset.Position(edit.Pos)
+			editEnd := editStart
+			if edit.End.IsValid() {
+				editEnd = fset.Position(edit.End)
+			}
+			sf.Edits = append(sf.Edits, TextEdit{
+				File:      relPath(dir, editStart.Filename),
+				NewText:   string(edit.NewText),
+				Line:      editStart.Line,
+				Column:    editStart.Column,
+				EndLine:   editEnd.Line,
+				EndColumn: editEnd.Column,
+			})
+		}
+		out.SuggestedFixes = append(out.SuggestedFixes, sf)
+	}
+
+	return out
+}
+
+func relPath(dir, file string) string {
+	if dir == "" || file == "" {
+		return file
+	}
+	rel, err := filepath.Rel(dir, file)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return file
+	}
+	return rel
+}
+
+// Sort orders diagnostics by file, position and rule.
+func Sort(diags []Diagnostic) {
+	sort.SliceStable(diags, func(i, j int) bool {
+		a, b := diags[i], diags[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		if a.Column != b.Column {
+			return a.Column < b.Column
+		}
+		return a.Rule < b.Rule
+	})
+}
+
+// Write renders diags to w in the given format. rules lists the names of all
+// rules the analyzer can report; it is used by formats that describe the
+// rules alongside the results (SARIF).
+func Write(w io.Writer, format string, diags []Diagnostic, rules []string) error {
+	switch format {
+	case FormatText:
+		return writeText(w, diags)
+	case FormatJSON:
+		return writeJSON(w, diags)
+	case FormatSARIF:
+		return writeSARIF(w, diags, rules)
+	case FormatCheckstyle:
+		return writeCheckstyle(w, diags)
+	default:
+		return fmt.Errorf("unknown output format %q (must be one of %s)", format, strings.Join(Formats, ", "))
+	}
+}
+
+// writeText prints one line per diagnostic in the usual file:line:col form.
+func writeText(w io.Writer, diags []Diagnostic) error {
+	for _, d := range diags {
+		message := d.Message
+		if d.Severity != config.SeverityError {
+			message = d.Severity + ": " + message
+		}
+		if _, err := fmt.Fprintf(w, "%s:%d:%d: %s (%s)\n", d.File, d.Line, d.Column, message, d.Rule); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeJSON prints the diagnostics as a flat JSON array.
+func writeJSON(w io.Writer, diags []Diagnostic) error {
+	if diags == nil {
+		diags = []Diagnostic{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(diags)
+}
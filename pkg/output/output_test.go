@@ -0,0 +1,234 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/AlexanderGhosty/log-linter/pkg/config"
+	"golang.org/x/tools/go/analysis"
+)
+
+func testDiagnostics() []Diagnostic {
+	return []Diagnostic{
+		{
+			Rule:     "lowercase",
+			Severity: config.SeverityError,
+			File:     "main.go",
+			Message:  "log message should start with a lowercase letter",
+			SuggestedFixes: []SuggestedFix{{
+				Message: `change to "starting"`,
+				Edits: []TextEdit{{
+					File: "main.go", NewText: `"starting"`,
+					Line: 3, Column: 11, EndLine: 3, EndColumn: 21,
+				}},
+			}},
+			Line: 3, Column: 11, EndLine: 3, EndColumn: 21,
+		},
+		{
+			Rule:     "sensitive",
+			Severity: config.SeverityWarning,
+			File:     "main.go",
+			Message:  "log field key may contain sensitive data",
+			Line:     4, Column: 20, EndLine: 4, EndColumn: 30,
+		},
+	}
+}
+
+func TestFromAnalysis(t *testing.T) {
+	fset := token.NewFileSet()
+	dir := filepath.Join("root", "project")
+	file := fset.AddFile(filepath.Join(dir, "main.go"), -1, 100)
+	file.SetLines([]int{0, 10, 20})
+
+	cfg := &config.Config{Rules: map[string]config.RuleConfig{
+		"sensitive": {Severity: config.SeverityWarning},
+	}}
+
+	tests := []struct {
+		name         string
+		wantRule     string
+		wantSeverity string
+		wantMessage  string
+		diag         analysis.Diagnostic
+	}{
+		{
+			name:         "error severity",
+			diag:         analysis.Diagnostic{Pos: file.Pos(12), End: file.Pos(15), Category: "lowercase", Message: "log message should start with a lowercase letter"},
+			wantRule:     "lowercase",
+			wantSeverity: config.SeverityError,
+			wantMessage:  "log message should start with a lowercase letter",
+		},
+		{
+			name:         "warning prefix is removed",
+			diag:         analysis.Diagnostic{Pos: file.Pos(12), End: file.Pos(15), Category: "sensitive", Message: "warning: log attribute contains sensitive data"},
+			wantRule:     "sensitive",
+			wantSeverity: config.SeverityWarning,
+			wantMessage:  "log attribute contains sensitive data",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FromAnalysis(fset, tt.diag, cfg, dir)
+			if got.Rule != tt.wantRule || got.Severity != tt.wantSeverity || got.Message != tt.wantMessage {
+				t.Errorf("FromAnalysis() = %+v, want rule %q, severity %q, message %q", got, tt.wantRule, tt.wantSeverity, tt.wantMessage)
+			}
+			if got.File != "main.go" || got.Line != 2 || got.Column != 3 || got.EndLine != 2 || got.EndColumn != 6 {
+				t.Errorf("FromAnalysis() position = %s:%d:%d-%d:%d, want main.go:2:3-2:6", got.File, got.Line, got.Column, got.EndLine, got.EndColumn)
+			}
+		})
+	}
+}
+
+func TestWrite_Text(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, FormatText, testDiagnostics(), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "main.go:3:11: log message should start with a lowercase letter (lowercase)\n" +
+		"main.go:4:20: warning: log field key may contain sensitive data (sensitive)\n"
+	if buf.String() != want {
+		t.Errorf("Write(text) = %q, want %q", buf.String(), want)
+	}
+}
+
+func TestWrite_JSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, FormatJSON, testDiagnostics(), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []Diagnostic
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, buf.String())
+	}
+	if len(got) != 2 {
+		t.Fatalf("got %d diagnostics, want 2", len(got))
+	}
+	if got[0].Rule != "lowercase" || got[0].SuggestedFixes[0].Edits[0].NewText != `"starting"` {
+		t.Errorf("unexpected first diagnostic: %+v", got[0])
+	}
+	if got[1].Severity != config.SeverityWarning {
+		t.Errorf("second diagnostic severity = %q, want %q", got[1].Severity, config.SeverityWarning)
+	}
+
+	buf.Reset()
+	if err := Write(&buf, FormatJSON, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if strings.TrimSpace(buf.String()) != "[]" {
+		t.Errorf("Write(json) without diagnostics = %q, want []", buf.String())
+	}
+}
+
+func TestWrite_SARIF(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, FormatSARIF, testDiagnostics(), []string{"english", "lowercase", "sensitive"}); err != nil {
+		t.Fatal(err)
+	}
+
+	var got sarifLog
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, buf.String())
+	}
+	if got.Version != "2.1.0" || len(got.Runs) != 1 {
+		t.Fatalf("unexpected SARIF log: %s", buf.String())
+	}
+
+	run := got.Runs[0]
+	if len(run.Tool.Driver.Rules) != 3 {
+		t.Errorf("got %d rules, want 3", len(run.Tool.Driver.Rules))
+	}
+	if len(run.Results) != 2 {
+		t.Fatalf("got %d results, want 2", len(run.Results))
+	}
+
+	tests := []struct {
+		ruleID    string
+		level     string
+		ruleIndex int
+		fixes     int
+	}{
+		{ruleID: "lowercase", level: "error", ruleIndex: 1, fixes: 1},
+		{ruleID: "sensitive", level: "warning", ruleIndex: 2, fixes: 0},
+	}
+	for i, tt := range tests {
+		res := run.Results[i]
+		if res.RuleID != tt.ruleID || res.Level != tt.level || res.RuleIndex != tt.ruleIndex || len(res.Fixes) != tt.fixes {
+			t.Errorf("result %d = {ruleId %q, level %q, ruleIndex %d, %d fixes}, want {%q, %q, %d, %d fixes}",
+				i, res.RuleID, res.Level, res.RuleIndex, len(res.Fixes), tt.ruleID, tt.level, tt.ruleIndex, tt.fixes)
+		}
+	}
+}
+
+func TestWrite_Checkstyle(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, FormatCheckstyle, testDiagnostics(), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	var got checkstyleOutput
+	if err := xml.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("invalid XML: %v\n%s", err, buf.String())
+	}
+	if len(got.Files) != 1 || got.Files[0].Name != "main.go" || len(got.Files[0].Errors) != 2 {
+		t.Fatalf("unexpected checkstyle report: %s", buf.String())
+	}
+	if e := got.Files[0].Errors[1]; e.Source != "loglinter.sensitive" || e.Severity != "warning" || e.Line != 4 {
+		t.Errorf("unexpected error entry: %+v", e)
+	}
+}
+
+func TestWrite_UnknownFormat(t *testing.T) {
+	if err := Write(&bytes.Buffer{}, "xml", nil, nil); err == nil {
+		t.Error("expected error for unknown format")
+	}
+}
+
+// TestWrite_SARIFColumns checks that SARIF regions are in Unicode code points
+// rather than bytes on lines with non-ASCII text.
+func TestWrite_SARIFColumns(t *testing.T) {
+	const file = "testdata/unicode.go"
+	diags := []Diagnostic{
+		{
+			Rule:     "english",
+			Severity: "error",
+			File:     file,
+			Message:  "log message should be in English",
+			Line:     6, Column: 12, EndLine: 6, EndColumn: 41,
+		},
+		{
+			Rule:     "english",
+			Severity: "error",
+			File:     file,
+			Message:  "log field key should be in English",
+			Line:     6, Column: 43, EndLine: 6, EndColumn: 53,
+			SuggestedFixes: []SuggestedFix{{
+				Message: `change to "key"`,
+				Edits: []TextEdit{{
+					File: file, NewText: `"key"`,
+					Line: 6, Column: 43, EndLine: 6, EndColumn: 53,
+				}},
+			}},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, FormatSARIF, diags, []string{"english"}); err != nil {
+		t.Fatal(err)
+	}
+	want, err := os.ReadFile("testdata/unicode.sarif.golden")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != string(want) {
+		t.Errorf("Write(sarif) =\n%s\nwant\n%s", buf.String(), want)
+	}
+}
@@ -0,0 +1,207 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"unicode/utf8"
+
+	"github.com/AlexanderGhosty/log-linter/pkg/config"
+)
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	toolName     = "loglinter"
+	toolURI      = "https://github.com/AlexanderGhosty/log-linter"
+)
+
+// The types below cover the subset of SARIF 2.1.0 written by loglinter.
+
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool       sarifTool     `json:"tool"`
+	ColumnKind string        `json:"columnKind"`
+	Results    []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+	Fixes     []sarifFix      `json:"fixes,omitempty"`
+	RuleIndex int             `json:"ruleIndex"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn,omitempty"`
+	EndLine     int `json:"endLine,omitempty"`
+	EndColumn   int `json:"endColumn,omitempty"`
+}
+
+type sarifFix struct {
+	Description     sarifMessage          `json:"description"`
+	ArtifactChanges []sarifArtifactChange `json:"artifactChanges"`
+}
+
+type sarifArtifactChange struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Replacements     []sarifReplacement    `json:"replacements"`
+}
+
+type sarifReplacement struct {
+	InsertedContent sarifMessage `json:"insertedContent"`
+	DeletedRegion   sarifRegion  `json:"deletedRegion"`
+}
+
+// writeSARIF prints the diagnostics as a SARIF 2.1.0 log with a single run.
+// Rule IDs are the rule names, so results stay comparable between versions.
+func writeSARIF(w io.Writer, diags []Diagnostic, rules []string) error {
+	driver := sarifDriver{
+		Name:           toolName,
+		InformationURI: toolURI,
+		Rules:          []sarifRule{},
+	}
+	ruleIndex := make(map[string]int)
+	addRule := func(name string) int {
+		if i, ok := ruleIndex[name]; ok {
+			return i
+		}
+		ruleIndex[name] = len(driver.Rules)
+		driver.Rules = append(driver.Rules, sarifRule{ID: name, Name: name})
+		return ruleIndex[name]
+	}
+	for _, name := range rules {
+		addRule(name)
+	}
+
+	columns := &runeColumns{lines: make(map[string][][]byte)}
+	region := func(file string, line, col, endLine, endCol int) sarifRegion {
+		return sarifRegion{
+			StartLine:   line,
+			StartColumn: columns.column(file, line, col),
+			EndLine:     endLine,
+			EndColumn:   columns.column(file, endLine, endCol),
+		}
+	}
+
+	results := make([]sarifResult, 0, len(diags))
+	for _, d := range diags {
+		result := sarifResult{
+			RuleID:    d.Rule,
+			RuleIndex: addRule(d.Rule),
+			Level:     sarifLevel(d.Severity),
+			Message:   sarifMessage{Text: d.Message},
+			Locations: []sarifLocation{{
+				PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: filepath.ToSlash(d.File)},
+					Region:           region(d.File, d.Line, d.Column, d.EndLine, d.EndColumn),
+				},
+			}},
+		}
+
+		for _, fix := range d.SuggestedFixes {
+			sf := sarifFix{Description: sarifMessage{Text: fix.Message}}
+			for _, edit := range fix.Edits {
+				sf.ArtifactChanges = append(sf.ArtifactChanges, sarifArtifactChange{
+					ArtifactLocation: sarifArtifactLocation{URI: filepath.ToSlash(edit.File)},
+					Replacements: []sarifReplacement{{
+						DeletedRegion:   region(edit.File, edit.Line, edit.Column, edit.EndLine, edit.EndColumn),
+						InsertedContent: sarifMessage{Text: edit.NewText},
+					}},
+				})
+			}
+			result.Fixes = append(result.Fixes, sf)
+		}
+
+		results = append(results, result)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(sarifLog{
+		Version: sarifVersion,
+		Schema:  sarifSchema,
+		Runs: []sarifRun{{
+			Tool:       sarifTool{Driver: driver},
+			ColumnKind: "unicodeCodePoints",
+			Results:    results,
+		}},
+	})
+}
+
+// runeColumns converts the byte columns of Go positions to the columns in
+// Unicode code points declared by the SARIF run, reading the lines of the
+// files as needed. Columns in files that cannot be read are left as they are.
+type runeColumns struct {
+	lines map[string][][]byte
+}
+
+// column returns the code point column of the byte column col of a line of
+// file.
+func (c *runeColumns) column(file string, line, col int) int {
+	lines, ok := c.lines[file]
+	if !ok {
+		if data, err := os.ReadFile(file); err == nil {
+			lines = bytes.Split(data, []byte("\n"))
+		}
+		c.lines[file] = lines
+	}
+	if line < 1 || line > len(lines) || col < 1 || col-1 > len(lines[line-1]) {
+		return col
+	}
+	return utf8.RuneCount(lines[line-1][:col-1]) + 1
+}
+
+// sarifLevel maps a rule severity to a SARIF result level.
+func sarifLevel(severity string) string {
+	switch severity {
+	case config.SeverityWarning:
+		return "warning"
+	case config.SeverityInfo:
+		return "note"
+	default:
+		return "error"
+	}
+}
@@ -0,0 +1,7 @@
+package main
+
+import "log/slog"
+
+func main() {
+	slog.Info("запуск сервера", "ключ", "значение")
+}
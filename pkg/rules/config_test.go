@@ -1,12 +1,11 @@
 package rules
 
 import (
-	"go/token"
 	"testing"
 )
 
 func TestSensitive_Config(t *testing.T) {
-	r := NewSensitive(nil, []string{"beer", "wine"}, nil)
+	r := NewSensitive(nil, []string{"beer", "wine"}, nil, nil, "")
 
 	tests := []struct {
 		name     string
@@ -20,7 +19,7 @@ func TestSensitive_Config(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			diags := r.Check(tt.input, token.NoPos, token.NoPos)
+			diags := r.Check(Message{Text: tt.input})
 			gotDiag := len(diags) > 0
 			if gotDiag != tt.wantDiag {
 				t.Errorf("Check(%q) diag = %v, want %v", tt.input, gotDiag, tt.wantDiag)
@@ -51,7 +50,7 @@ func TestSymbols_Config(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			diags := r.Check(tt.msg, token.NoPos, token.NoPos)
+			diags := r.Check(Message{Text: tt.msg})
 			gotDiag := len(diags) > 0
 			if gotDiag != tt.wantDiag {
 				t.Errorf("Check(%q): got diagnostic=%v, want diagnostic=%v", tt.msg, gotDiag, tt.wantDiag)
@@ -4,6 +4,7 @@ import (
 	"go/ast"
 	"go/constant"
 	"go/token"
+	"strings"
 	"unicode"
 
 	"github.com/AlexanderGhosty/log-linter/pkg/logsupport"
@@ -11,20 +12,87 @@ import (
 	"golang.org/x/tools/go/analysis"
 )
 
-// English checks that log messages consist of ASCII characters.
+// English checks that log messages are written in the configured language,
+// English by default, i.e. that their letters are ASCII letters or letters
+// of the language's scripts. Other letters may be allowed by script, by rune
+// range or for whole words.
 type English struct {
-	registry *logsupport.Registry
+	registry  *logsupport.Registry
+	words     map[string]bool
+	language  utils.Language
+	scripts   []*unicode.RangeTable
+	ranges    [][2]rune
+	overrides []englishOverride
 }
 
-// NewEnglish creates a new English rule.
-func NewEnglish(registry *logsupport.Registry) Rule {
+// EnglishOptions configures the English rule.
+type EnglishOptions struct {
+	// Language is the ISO 639-1 code of the required language; empty means English.
+	Language string
+	// Scripts are the names of additionally allowed Unicode scripts (e.g. "Latin").
+	Scripts []string
+	// Ranges are additionally allowed rune ranges (e.g. "U+00C0-U+00FF").
+	Ranges []string
+	// Words are allowed whatever their letters (e.g. "café").
+	Words []string
+}
+
+// EnglishOverride applies other options to the packages matching one of the
+// patterns (see utils.MatchPackage).
+type EnglishOverride struct {
+	Packages []string
+	Options  EnglishOptions
+}
+
+type englishOverride struct {
+	rule     *English
+	packages []string
+}
+
+// NewEnglish creates a new English rule. The first override matching the
+// analyzed package replaces opts.
+func NewEnglish(registry *logsupport.Registry, opts EnglishOptions, overrides []EnglishOverride) Rule {
 	if registry == nil {
 		registry = logsupport.NewRegistry(nil)
 	}
 
-	return &English{
+	r := newEnglish(registry, opts)
+	for _, o := range overrides {
+		r.overrides = append(r.overrides, englishOverride{
+			rule:     newEnglish(registry, o.Options),
+			packages: o.Packages,
+		})
+	}
+	return r
+}
+
+func newEnglish(registry *logsupport.Registry, opts EnglishOptions) *English {
+	// Options are expected to be pre-validated by config.Validate(); unknown
+	// languages, scripts and ranges are ignored.
+	language, ok := utils.LookupLanguage(opts.Language)
+	if !ok {
+		language, _ = utils.LookupLanguage("en")
+	}
+
+	r := &English{
 		registry: registry,
+		language: language,
+		words:    make(map[string]bool, len(opts.Words)),
+	}
+	for _, name := range opts.Scripts {
+		if table, ok := unicode.Scripts[name]; ok {
+			r.scripts = append(r.scripts, table)
+		}
+	}
+	for _, s := range opts.Ranges {
+		if lo, hi, err := utils.ParseRuneRange(s); err == nil {
+			r.ranges = append(r.ranges, [2]rune{lo, hi})
+		}
 	}
+	for _, w := range opts.Words {
+		r.words[strings.ToLower(w)] = true
+	}
+	return r
 }
 
 // Name returns the name of the rule.
@@ -32,19 +100,109 @@ func (r *English) Name() string {
 	return "english"
 }
 
+// ForPackage returns the rule configured for the package.
+func (r *English) ForPackage(pkgPath string) Rule {
+	for _, o := range r.overrides {
+		for _, pattern := range o.packages {
+			if utils.MatchPackage(pattern, pkgPath) {
+				return o.rule
+			}
+		}
+	}
+	return r
+}
+
 // Check validates a single log message string.
-func (r *English) Check(msg string, pos, end token.Pos) []analysis.Diagnostic {
+func (r *English) Check(msg Message) []analysis.Diagnostic {
+	if start, end := r.foreignWords(msg.Text); start >= 0 {
+		return r.diagnose(msg.Range(start, end))
+	}
+	if !r.usesLanguage(msg.Text) {
+		return r.diagnose(msg.Pos, msg.End)
+	}
+	return nil
+}
+
+// CheckFragment validates a fragment of a message built at run time. Only its
+// letters are checked: the letters of the language may be in another part.
+func (r *English) CheckFragment(fragment Message, _ bool) []analysis.Diagnostic {
+	if start, end := r.foreignWords(fragment.Text); start >= 0 {
+		return r.diagnose(fragment.Range(start, end))
+	}
+	return nil
+}
+
+func (r *English) diagnose(pos, end token.Pos) []analysis.Diagnostic {
+	return []analysis.Diagnostic{{
+		Pos:     pos,
+		End:     end,
+		Message: "log message should be in " + r.language.Name,
+	}}
+}
+
+// foreignWords returns the byte range of s from the first to the last word
+// that has letters which are not allowed and is not an allowed word, or
+// -1, -1 if there is none.
+func (r *English) foreignWords(s string) (int, int) {
+	isLetter := func(ch rune) bool { return unicode.IsLetter(ch) || unicode.IsMark(ch) }
+	first, last := -1, -1
+	for i := 0; i < len(s); {
+		start := strings.IndexFunc(s[i:], isLetter)
+		if start < 0 {
+			break
+		}
+		start += i
+		end := strings.IndexFunc(s[start:], func(ch rune) bool { return !isLetter(ch) })
+		if end < 0 {
+			end = len(s)
+		} else {
+			end += start
+		}
+		w := s[start:end]
+		if !r.words[strings.ToLower(w)] && strings.IndexFunc(w, r.forbidden) >= 0 {
+			if first < 0 {
+				first = start
+			}
+			last = end
+		}
+		i = end
+	}
+	return first, last
+}
+
+// forbidden reports whether ch is a letter that is not allowed.
+func (r *English) forbidden(ch rune) bool {
 	const maxASCII = 127
-	for _, ch := range msg {
-		if unicode.IsLetter(ch) && ch > maxASCII {
-			return []analysis.Diagnostic{{
-				Pos:     pos,
-				End:     end,
-				Message: "log message should be in English",
-			}}
+	if !unicode.IsLetter(ch) || ch <= maxASCII || unicode.In(ch, r.language.Scripts...) || unicode.In(ch, r.scripts...) {
+		return false
+	}
+	for _, rng := range r.ranges {
+		if rng[0] <= ch && ch <= rng[1] {
+			return false
 		}
 	}
-	return nil
+	return true
+}
+
+// usesLanguage reports whether s, if it contains letters, contains a letter
+// of the required language's scripts. ASCII letters are allowed in any
+// language, for identifiers and format verbs, but a message made only of them
+// is not in, say, Russian.
+func (r *English) usesLanguage(s string) bool {
+	if len(r.language.Scripts) == 0 {
+		return true
+	}
+	hasLetters := false
+	for _, ch := range s {
+		if !unicode.IsLetter(ch) {
+			continue
+		}
+		if unicode.In(ch, r.language.Scripts...) {
+			return true
+		}
+		hasLetters = true
+	}
+	return !hasLetters
 }
 
 // CheckCall analyzes a full log call expression.
@@ -52,10 +210,10 @@ func (r *English) CheckCall(call *ast.CallExpr, pass *analysis.Pass) []analysis.
 	var diags []analysis.Diagnostic
 
 	// Determine message index to skip it (it is checked by Check method)
-	pkgPath, funcName, ok := utils.ResolveCallPackagePath(pass, call)
+	lc, ok := r.registry.Resolve(pass, call)
 	msgIndex := -1
 	if ok {
-		msgIndex = r.registry.MessageIndex(pkgPath, funcName)
+		msgIndex = lc.MessageIndex
 	}
 
 	r.registry.InspectLogArgs(pass, call, msgIndex, func(arg ast.Expr, isKey bool) {
@@ -64,9 +222,9 @@ func (r *English) CheckCall(call *ast.CallExpr, pass *analysis.Pass) []analysis.
 			tv, ok := pass.TypesInfo.Types[arg]
 			if ok && tv.Value != nil && tv.Value.Kind() == constant.String {
 				val := constant.StringVal(tv.Value)
-				if d := r.Check(val, arg.Pos(), arg.End()); len(d) > 0 {
-					diags = append(diags, d...)
-				}
+				// Keys are identifiers rather than prose, so they are not
+				// required to be in the language, only to use its letters.
+				diags = append(diags, r.CheckFragment(NewMessage(val, arg), false)...)
 			}
 		}
 	})
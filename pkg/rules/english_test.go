@@ -1,21 +1,20 @@
 package rules
 
 import (
-	"go/token"
 	"testing"
 
 	"github.com/AlexanderGhosty/log-linter/pkg/logsupport"
 )
 
 func TestEnglish_Name(t *testing.T) {
-	r := NewEnglish(logsupport.NewRegistry(nil))
+	r := NewEnglish(logsupport.NewRegistry(nil), EnglishOptions{}, nil)
 	if r.Name() != "english" {
 		t.Errorf("expected name 'english', got %q", r.Name())
 	}
 }
 
 func TestEnglish_Check(t *testing.T) {
-	r := NewEnglish(logsupport.NewRegistry(nil))
+	r := NewEnglish(logsupport.NewRegistry(nil), EnglishOptions{}, nil)
 
 	tests := []struct {
 		name     string
@@ -38,7 +37,7 @@ func TestEnglish_Check(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			diags := r.Check(tt.msg, token.NoPos, token.NoPos)
+			diags := r.Check(Message{Text: tt.msg})
 			gotDiag := len(diags) > 0
 			if gotDiag != tt.wantDiag {
 				t.Errorf("Check(%q): got diagnostic=%v, want diagnostic=%v", tt.msg, gotDiag, tt.wantDiag)
@@ -49,3 +48,78 @@ func TestEnglish_Check(t *testing.T) {
 		})
 	}
 }
+
+func TestEnglish_Options(t *testing.T) {
+	tests := []struct {
+		name     string
+		msg      string
+		wantDiag string
+		opts     EnglishOptions
+	}{
+		{name: "accented word allowed", opts: EnglishOptions{Words: []string{"Café"}}, msg: "café opened", wantDiag: ""},
+		{name: "other words still checked", opts: EnglishOptions{Words: []string{"café"}}, msg: "café über", wantDiag: "log message should be in English"},
+		{name: "script allowed", opts: EnglishOptions{Scripts: []string{"Latin"}}, msg: "über alles", wantDiag: ""},
+		{name: "script does not allow others", opts: EnglishOptions{Scripts: []string{"Latin"}}, msg: "запуск", wantDiag: "log message should be in English"},
+		{name: "range allowed", opts: EnglishOptions{Ranges: []string{"U+00C0-U+00FF"}}, msg: "café", wantDiag: ""},
+		{name: "range excludes others", opts: EnglishOptions{Ranges: []string{"U+00C0-U+00FF"}}, msg: "łódź", wantDiag: "log message should be in English"},
+		{name: "russian message", opts: EnglishOptions{Language: "ru"}, msg: "запуск сервера на порту %d", wantDiag: ""},
+		{name: "russian with acronym", opts: EnglishOptions{Language: "ru"}, msg: "ошибка HTTP", wantDiag: ""},
+		{name: "english instead of russian", opts: EnglishOptions{Language: "ru"}, msg: "starting server", wantDiag: "log message should be in Russian"},
+		{name: "greek instead of russian", opts: EnglishOptions{Language: "ru"}, msg: "запуск αβγ", wantDiag: "log message should be in Russian"},
+		{name: "no letters in russian", opts: EnglishOptions{Language: "ru"}, msg: "12345", wantDiag: ""},
+		{name: "german", opts: EnglishOptions{Language: "de"}, msg: "Größe überschritten", wantDiag: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewEnglish(logsupport.NewRegistry(nil), tt.opts, nil)
+			diags := r.Check(Message{Text: tt.msg})
+			got := ""
+			if len(diags) > 0 {
+				got = diags[0].Message
+			}
+			if got != tt.wantDiag {
+				t.Errorf("Check(%q) = %q, want %q", tt.msg, got, tt.wantDiag)
+			}
+		})
+	}
+}
+
+func TestEnglish_ForPackage(t *testing.T) {
+	r := NewEnglish(logsupport.NewRegistry(nil), EnglishOptions{}, []EnglishOverride{
+		{Packages: []string{"example.com/app/i18n/..."}, Options: EnglishOptions{Language: "ru"}},
+		{Packages: []string{"example.com/app/i18n/legacy", "example.com/*/cafe"}, Options: EnglishOptions{Words: []string{"café"}}},
+	}).(PackageRule)
+
+	tests := []struct {
+		pkgPath  string
+		msg      string
+		wantDiag bool
+	}{
+		{pkgPath: "example.com/app", msg: "запуск", wantDiag: true},
+		{pkgPath: "example.com/app/i18n", msg: "запуск", wantDiag: false},
+		// The first matching override applies.
+		{pkgPath: "example.com/app/i18n/legacy", msg: "запуск", wantDiag: false},
+		{pkgPath: "example.com/web/cafe", msg: "café", wantDiag: false},
+		{pkgPath: "example.com/web/cafe", msg: "запуск", wantDiag: true},
+	}
+
+	for _, tt := range tests {
+		got := len(r.ForPackage(tt.pkgPath).Check(Message{Text: tt.msg})) > 0
+		if got != tt.wantDiag {
+			t.Errorf("ForPackage(%q).Check(%q): got diagnostic=%v, want %v", tt.pkgPath, tt.msg, got, tt.wantDiag)
+		}
+	}
+}
+
+func TestEnglish_CheckFragment(t *testing.T) {
+	r := NewEnglish(logsupport.NewRegistry(nil), EnglishOptions{Language: "ru"}, nil).(FragmentRule)
+
+	// The Cyrillic letters a Russian message needs may be in another fragment.
+	if diags := r.CheckFragment(Message{Text: "user "}, true); len(diags) > 0 {
+		t.Errorf("unexpected diagnostic: %q", diags[0].Message)
+	}
+	if diags := r.CheckFragment(Message{Text: " 服务器"}, false); len(diags) == 0 {
+		t.Error("expected diagnostic for letters of another script")
+	}
+}
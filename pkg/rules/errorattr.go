@@ -0,0 +1,355 @@
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"github.com/AlexanderGhosty/log-linter/pkg/logsupport"
+	"github.com/AlexanderGhosty/log-linter/pkg/ssautil"
+	"golang.org/x/tools/go/analysis"
+)
+
+// errorKey is the attribute key errors are logged under, as by zap.Error.
+const errorKey = "error"
+
+// ErrorAttr checks that slog and zap calls log errors as one consistent
+// attribute: "error", err for slog and zap's sugared "w" methods, and
+// zap.Error(err) for zap fields. Errors formatted into the message, logged
+// under another key or logged as strings (err.Error()) are reported.
+type ErrorAttr struct {
+	registry *logsupport.Registry
+}
+
+// NewErrorAttr creates a new ErrorAttr rule.
+func NewErrorAttr(registry *logsupport.Registry) Rule {
+	if registry == nil {
+		registry = logsupport.NewRegistry(nil)
+	}
+
+	return &ErrorAttr{registry: registry}
+}
+
+// Name returns the name of the rule.
+func (r *ErrorAttr) Name() string {
+	return "error-attribute"
+}
+
+// Check does nothing: constant messages contain no errors.
+func (r *ErrorAttr) Check(Message) []analysis.Diagnostic {
+	return nil
+}
+
+// CheckCall analyzes the message and attributes of a slog or zap call.
+func (r *ErrorAttr) CheckCall(call *ast.CallExpr, pass *analysis.Pass) []analysis.Diagnostic {
+	lc, ok := r.registry.Resolve(pass, call)
+	if !ok || (lc.UserType != "slog" && lc.UserType != "zap") {
+		return nil
+	}
+
+	return append(r.checkMessage(pass, lc, call), r.checkAttrs(pass, lc, call)...)
+}
+
+// usage returns how the error should be logged by the call, e.g. "error", err.
+// zap's sugared printf-style methods take no attributes, so their key-value
+// ("w") counterpart is named.
+func usage(lc logsupport.Call, err ast.Expr) string {
+	if lc.UserType == "zap" && lc.Printf {
+		return fmt.Sprintf("%q, %s with %sw", errorKey, types.ExprString(err), strings.TrimSuffix(lc.Func, "f"))
+	}
+	if lc.UserType == "zap" && !lc.KeyValues {
+		return fmt.Sprintf("zap.Error(%s)", types.ExprString(err))
+	}
+	return fmt.Sprintf("%q, %s", errorKey, types.ExprString(err))
+}
+
+// checkMessage reports the errors formatted into the message of a call.
+func (r *ErrorAttr) checkMessage(pass *analysis.Pass, lc logsupport.Call, call *ast.CallExpr) []analysis.Diagnostic {
+	if lc.MessageIndex < 0 || lc.MessageIndex >= len(call.Args) {
+		return nil
+	}
+
+	var diags []analysis.Diagnostic
+	report := func(expr, err ast.Expr) {
+		diags = append(diags, analysis.Diagnostic{
+			Pos:     expr.Pos(),
+			End:     expr.End(),
+			Message: fmt.Sprintf("error %s is formatted into the log message; log it as an attribute: %s", types.ExprString(err), usage(lc, err)),
+		})
+	}
+
+	if lc.Printf {
+		for _, arg := range printedOperands(pass, call, lc.MessageIndex) {
+			if err, _, ok := errorValue(pass, arg); ok {
+				report(arg, err)
+			}
+		}
+		return diags
+	}
+
+	arg := call.Args[lc.MessageIndex]
+	errs := messageErrors(pass, arg)
+	for _, e := range errs {
+		report(e[0], e[1])
+	}
+	if len(errs) == 1 {
+		if fix := r.messageFix(pass, lc, call, errs[0][1]); fix != nil {
+			diags[0].SuggestedFixes = []analysis.SuggestedFix{*fix}
+		}
+	}
+	return diags
+}
+
+// messageErrors returns the errors in a message built from concatenations
+// and fmt.Sprint or fmt.Sprintf calls, as pairs of the expression in the
+// message (e.g. err.Error()) and the error (err).
+func messageErrors(pass *analysis.Pass, expr ast.Expr) [][2]ast.Expr {
+	expr = ast.Unparen(expr)
+	if err, _, ok := errorValue(pass, expr); ok {
+		return [][2]ast.Expr{{expr, err}}
+	}
+
+	var args []ast.Expr
+	switch expr := expr.(type) {
+	case *ast.BinaryExpr:
+		args = []ast.Expr{expr.X, expr.Y}
+	case *ast.CallExpr:
+		switch logsupport.MessageFunc(pass, expr) {
+		case "fmt.Sprintf":
+			args = expr.Args[1:]
+		case "fmt.Sprint", "fmt.Sprintln":
+			args = expr.Args
+		}
+	}
+	var errs [][2]ast.Expr
+	for _, arg := range args {
+		errs = append(errs, messageErrors(pass, arg)...)
+	}
+	return errs
+}
+
+// messageFix returns the fix that removes err, the only value of a message
+// built at run time, from the message and logs it as an attribute instead.
+func (r *ErrorAttr) messageFix(pass *analysis.Pass, lc logsupport.Call, call *ast.CallExpr, err ast.Expr) *analysis.SuggestedFix {
+	if call.Ellipsis.IsValid() || lc.Func == "LogAttrs" || lc.ArgsIndex != lc.MessageIndex+1 {
+		return nil
+	}
+	arg := call.Args[lc.MessageIndex]
+	parts, ok := templateParts(pass, arg)
+	if !ok {
+		return nil
+	}
+	for _, p := range parts {
+		if p.value == nil {
+			continue
+		}
+		if e, _, ok := errorValue(pass, p.value); !ok || e != err {
+			return nil
+		}
+	}
+	msg := templateText(parts)
+	if msg == "" || r.hasErrorKey(pass, call, lc) {
+		return nil
+	}
+
+	prefix, suffix := strconv.Quote(msg)+", "+strconv.Quote(errorKey)+", ", ""
+	var edits []analysis.TextEdit
+	if !lc.KeyValues {
+		if lc.UserType != "zap" {
+			return nil
+		}
+		qualifier, importEdits, ok := importQualifier(pass, "go.uber.org/zap", arg.Pos())
+		if !ok {
+			return nil
+		}
+		prefix, suffix, edits = strconv.Quote(msg)+", "+qualifier+"Error(", ")", importEdits
+	}
+
+	return &analysis.SuggestedFix{
+		Message: fmt.Sprintf("log %s as an attribute", types.ExprString(err)),
+		TextEdits: append(edits,
+			analysis.TextEdit{Pos: arg.Pos(), End: err.Pos(), NewText: []byte(prefix)},
+			analysis.TextEdit{Pos: err.End(), End: arg.End(), NewText: []byte(suffix)},
+		),
+	}
+}
+
+// hasErrorKey reports whether the call already logs an attribute under errorKey.
+func (r *ErrorAttr) hasErrorKey(pass *analysis.Pass, call *ast.CallExpr, lc logsupport.Call) bool {
+	found := false
+	r.registry.InspectLogArgs(pass, call, lc.MessageIndex, func(arg ast.Expr, isKey bool) {
+		if !isKey {
+			return
+		}
+		if tv, ok := pass.TypesInfo.Types[arg]; ok && tv.Value != nil && tv.Value.Kind() == constant.String {
+			found = found || constant.StringVal(tv.Value) == errorKey
+		} else if _, _, ok := errorValue(pass, arg); ok {
+			// The argument of zap.Error.
+			found = true
+		}
+	})
+	return found
+}
+
+// checkAttrs reports the errors logged under another key than errorKey, as
+// strings, or, for zap fields, with another constructor than zap.Error.
+func (r *ErrorAttr) checkAttrs(pass *analysis.Pass, lc logsupport.Call, call *ast.CallExpr) []analysis.Diagnostic {
+	var diags []analysis.Diagnostic
+	var key ast.Expr
+	r.registry.InspectLogArgs(pass, call, lc.MessageIndex, func(arg ast.Expr, isKey bool) {
+		if isKey {
+			// The argument of zap.Error(err) is reported as a key too.
+			key = arg
+			return
+		}
+		k := key
+		key = nil
+
+		err, stringified, ok := errorValue(pass, arg)
+		if !ok {
+			return
+		}
+		if field := enclosingField(call, arg); field != nil {
+			if d, ok := r.checkField(pass, lc, field, k, err, stringified); ok {
+				diags = append(diags, d)
+			}
+			return
+		}
+
+		keyName, keyKnown := "", false
+		if k != nil {
+			if tv, ok := pass.TypesInfo.Types[k]; ok && tv.Value != nil && tv.Value.Kind() == constant.String {
+				keyName, keyKnown = constant.StringVal(tv.Value), true
+			}
+		}
+		if keyName == errorKey && !stringified {
+			return
+		}
+
+		d := analysis.Diagnostic{Pos: arg.Pos(), End: arg.End()}
+		var edits []analysis.TextEdit
+		fixable := true
+		switch {
+		case k == nil:
+			d.Message = fmt.Sprintf("error %s is logged without a key; use %s", types.ExprString(err), usage(lc, err))
+			edits = append(edits, analysis.TextEdit{Pos: arg.Pos(), End: arg.Pos(), NewText: []byte(strconv.Quote(errorKey) + ", ")})
+		case keyName != errorKey:
+			d.Message = fmt.Sprintf("error %s is logged under key %s; use %s", types.ExprString(err), describeKey(pass, k), usage(lc, err))
+			_, isLit := ast.Unparen(k).(*ast.BasicLit)
+			fixable = isLit && keyKnown
+			edits = append(edits, analysis.TextEdit{Pos: k.Pos(), End: k.End(), NewText: []byte(strconv.Quote(errorKey))})
+		default:
+			d.Message = fmt.Sprintf("error %s is logged as a string; use %s", types.ExprString(err), usage(lc, err))
+		}
+		if stringified {
+			edits = append(edits,
+				analysis.TextEdit{Pos: arg.Pos(), End: err.Pos()},
+				analysis.TextEdit{Pos: err.End(), End: arg.End()},
+			)
+		}
+		if fixable {
+			d.SuggestedFixes = []analysis.SuggestedFix{{
+				Message:   "change to " + usage(lc, err),
+				TextEdits: edits,
+			}}
+		}
+		diags = append(diags, d)
+	})
+
+	return diags
+}
+
+// checkField checks an error logged with an attribute constructor such as
+// slog.Any(key, err) or zap.String(key, err.Error()). slog.Any("error", err)
+// and, for zap, zap.Error(err) and zap.NamedError("error", err) are accepted.
+func (r *ErrorAttr) checkField(pass *analysis.Pass, lc logsupport.Call, field *ast.CallExpr, key, err ast.Expr, stringified bool) (analysis.Diagnostic, bool) {
+	sel, ok := field.Fun.(*ast.SelectorExpr)
+	if !ok || len(field.Args) != 2 || key != field.Args[0] {
+		return analysis.Diagnostic{}, false
+	}
+	keyName := ""
+	if tv, ok := pass.TypesInfo.Types[key]; ok && tv.Value != nil && tv.Value.Kind() == constant.String {
+		keyName = constant.StringVal(tv.Value)
+	}
+
+	want, constructor := fmt.Sprintf("slog.Any(%q, %s)", errorKey, types.ExprString(err)), "Any"
+	if lc.UserType == "zap" {
+		want, constructor = fmt.Sprintf("zap.Error(%s)", types.ExprString(err)), "Error"
+		if sel.Sel.Name == "NamedError" && keyName == errorKey {
+			return analysis.Diagnostic{}, false
+		}
+	} else if sel.Sel.Name == "Any" && keyName == errorKey && !stringified {
+		return analysis.Diagnostic{}, false
+	}
+
+	d := analysis.Diagnostic{
+		Pos:     field.Pos(),
+		End:     field.End(),
+		Message: fmt.Sprintf("error %s should be logged as %s", types.ExprString(err), want),
+	}
+	// The constructor is rewritten with the same package qualifier.
+	if pkg, ok := sel.X.(*ast.Ident); ok {
+		if _, ok := pass.TypesInfo.Uses[pkg].(*types.PkgName); ok {
+			prefix := pkg.Name + "." + constructor + "("
+			if constructor == "Any" {
+				prefix += strconv.Quote(errorKey) + ", "
+			}
+			d.SuggestedFixes = []analysis.SuggestedFix{{
+				Message: "change to " + want,
+				TextEdits: []analysis.TextEdit{
+					{Pos: field.Pos(), End: err.Pos(), NewText: []byte(prefix)},
+					{Pos: err.End(), End: field.End(), NewText: []byte(")")},
+				},
+			}}
+		}
+	}
+	return d, true
+}
+
+// enclosingField returns the attribute constructor call among the arguments
+// of call that contains arg, or nil if arg is an argument of call.
+func enclosingField(call *ast.CallExpr, arg ast.Expr) *ast.CallExpr {
+	for _, a := range call.Args {
+		if field, ok := a.(*ast.CallExpr); ok && a != arg && field.Pos() <= arg.Pos() && arg.End() <= field.End() {
+			return field
+		}
+	}
+	return nil
+}
+
+// errorValue returns the error logged by expr: err for an expression err of
+// a type implementing error, and for err.Error() or a conversion of it to a
+// string or byte slice, such as string(err.Error()), in which case
+// stringified is true. Conversions to other types, as to error or to another
+// error type, are errors themselves.
+func errorValue(pass *analysis.Pass, expr ast.Expr) (err ast.Expr, stringified, ok bool) {
+	expr = ast.Unparen(expr)
+	if call, isCall := expr.(*ast.CallExpr); isCall {
+		if tv, found := pass.TypesInfo.Types[call.Fun]; found && tv.IsType() && len(call.Args) == 1 && isStringType(tv.Type) {
+			err, _, ok = errorValue(pass, call.Args[0])
+			return err, true, ok
+		}
+		if sel, isSel := call.Fun.(*ast.SelectorExpr); isSel && sel.Sel.Name == "Error" && len(call.Args) == 0 && ssautil.IsError(pass.TypesInfo.TypeOf(sel.X)) {
+			return ast.Unparen(sel.X), true, true
+		}
+	}
+	if ssautil.IsError(pass.TypesInfo.TypeOf(expr)) {
+		return expr, false, true
+	}
+	return nil, false, false
+}
+
+// isStringType reports whether the underlying type of t is string or []byte.
+func isStringType(t types.Type) bool {
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		return u.Info()&types.IsString != 0
+	case *types.Slice:
+		elem, ok := u.Elem().Underlying().(*types.Basic)
+		return ok && elem.Kind() == types.Byte
+	}
+	return false
+}
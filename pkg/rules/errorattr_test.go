@@ -0,0 +1,17 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/AlexanderGhosty/log-linter/pkg/logsupport"
+)
+
+func TestErrorAttr_Name(t *testing.T) {
+	r := NewErrorAttr(logsupport.NewRegistry(nil))
+	if r.Name() != "error-attribute" {
+		t.Errorf("expected name 'error-attribute', got %q", r.Name())
+	}
+	if diags := r.Check(Message{Text: "failed to load user"}); len(diags) > 0 {
+		t.Errorf("Check reported constant messages: %v", diags)
+	}
+}
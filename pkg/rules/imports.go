@@ -0,0 +1,74 @@
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+	"path"
+	"strconv"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// importQualifier returns the prefix that refers to the package pkgPath at
+// pos (e.g. "redact."), with the edit that imports the package if the file
+// does not already. It fails if the package name is shadowed.
+func importQualifier(pass *analysis.Pass, pkgPath string, pos token.Pos) (string, []analysis.TextEdit, bool) {
+	if pkgPath == pass.Pkg.Path() {
+		return "", nil, true
+	}
+
+	var file *ast.File
+	for _, f := range pass.Files {
+		if f.FileStart <= pos && pos <= f.FileEnd {
+			file = f
+			break
+		}
+	}
+	if file == nil {
+		return "", nil, false
+	}
+	scope := pass.Pkg.Scope().Innermost(pos)
+	if scope == nil {
+		return "", nil, false
+	}
+
+	for _, spec := range file.Imports {
+		if p, err := strconv.Unquote(spec.Path.Value); err != nil || p != pkgPath {
+			continue
+		}
+		pkgName := pass.TypesInfo.PkgNameOf(spec)
+		if pkgName == nil || pkgName.Name() == "_" {
+			continue
+		}
+		if pkgName.Name() == "." {
+			return "", nil, true
+		}
+		if _, obj := scope.LookupParent(pkgName.Name(), pos); obj != pkgName {
+			return "", nil, false
+		}
+		return pkgName.Name() + ".", nil, true
+	}
+
+	// Assume the package name is the last element of its path.
+	name := path.Base(pkgPath)
+	if _, obj := scope.LookupParent(name, pos); obj != nil || !token.IsIdentifier(name) {
+		return "", nil, false
+	}
+	return name + ".", []analysis.TextEdit{addImport(file, pkgPath)}, true
+}
+
+// addImport returns an edit that adds an import of pkgPath to file.
+func addImport(file *ast.File, pkgPath string) analysis.TextEdit {
+	spec := strconv.Quote(pkgPath)
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.IMPORT {
+			continue
+		}
+		if gen.Lparen.IsValid() {
+			return analysis.TextEdit{Pos: gen.Lparen + 1, End: gen.Lparen + 1, NewText: []byte("\n\t" + spec)}
+		}
+		return analysis.TextEdit{Pos: gen.End(), End: gen.End(), NewText: []byte("\nimport " + spec)}
+	}
+	return analysis.TextEdit{Pos: file.Name.End(), End: file.Name.End(), NewText: []byte("\n\nimport " + spec)}
+}
@@ -0,0 +1,124 @@
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"regexp"
+
+	"github.com/AlexanderGhosty/log-linter/pkg/logsupport"
+	"github.com/AlexanderGhosty/log-linter/pkg/utils"
+	"golang.org/x/tools/go/analysis"
+)
+
+// KeyStyle checks that constant attribute keys follow a naming convention
+// and that no key is repeated within a log call.
+type KeyStyle struct {
+	registry *logsupport.Registry
+	// pattern is the regular expression keys must match as a whole with the
+	// "regex" style, as configured in source; style is unused then.
+	pattern *regexp.Regexp
+	source  string
+	style   utils.KeyStyle
+}
+
+// NewKeyStyle creates a new KeyStyle rule for the given convention (see
+// utils.LookupKeyStyle; snake_case if empty), or for keys matching pattern as
+// a whole if style is "regex".
+func NewKeyStyle(registry *logsupport.Registry, style, pattern string) Rule {
+	if registry == nil {
+		registry = logsupport.NewRegistry(nil)
+	}
+
+	r := &KeyStyle{registry: registry}
+	if style == "regex" {
+		r.pattern = regexp.MustCompile("^(?:" + pattern + ")$")
+		r.source = pattern
+		return r
+	}
+	if style == "" {
+		style = "snake_case"
+	}
+	r.style, _ = utils.LookupKeyStyle(style)
+	return r
+}
+
+// Name returns the name of the rule.
+func (r *KeyStyle) Name() string {
+	return "key-style"
+}
+
+// Check does nothing: the rule only applies to attribute keys.
+func (r *KeyStyle) Check(Message) []analysis.Diagnostic {
+	return nil
+}
+
+// CheckCall analyzes the constant attribute keys of a log call.
+func (r *KeyStyle) CheckCall(call *ast.CallExpr, pass *analysis.Pass) []analysis.Diagnostic {
+	lc, ok := r.registry.Resolve(pass, call)
+	if !ok {
+		return nil
+	}
+
+	var diags []analysis.Diagnostic
+	seen := make(map[string]bool)
+	r.registry.InspectLogArgs(pass, call, lc.MessageIndex, func(arg ast.Expr, isKey bool) {
+		if !isKey {
+			return
+		}
+		tv, ok := pass.TypesInfo.Types[arg]
+		if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+			return
+		}
+		key := constant.StringVal(tv.Value)
+
+		if seen[key] {
+			diags = append(diags, analysis.Diagnostic{
+				Pos:     arg.Pos(),
+				End:     arg.End(),
+				Message: fmt.Sprintf("duplicate log field key %q", key),
+			})
+			return
+		}
+		seen[key] = true
+
+		if d, ok := r.checkKey(key, arg); ok {
+			diags = append(diags, d)
+		}
+	})
+
+	return diags
+}
+
+// checkKey reports key, the value of arg, if it does not follow the
+// convention. Key literals come with a fix.
+func (r *KeyStyle) checkKey(key string, arg ast.Expr) (analysis.Diagnostic, bool) {
+	if r.pattern != nil {
+		if r.pattern.MatchString(key) {
+			return analysis.Diagnostic{}, false
+		}
+		return analysis.Diagnostic{
+			Pos:     arg.Pos(),
+			End:     arg.End(),
+			Message: fmt.Sprintf("log field key %q should match pattern %s", key, r.source),
+		}, true
+	}
+
+	if r.style.Match(key) {
+		return analysis.Diagnostic{}, false
+	}
+	d := analysis.Diagnostic{
+		Pos:     arg.Pos(),
+		End:     arg.End(),
+		Message: fmt.Sprintf("log field key %q should be %s", key, r.style.Name),
+	}
+	if lit, ok := arg.(*ast.BasicLit); ok {
+		if fixed := r.style.Convert(key); fixed != "" {
+			d.SuggestedFixes = []analysis.SuggestedFix{{
+				Message:   fmt.Sprintf("change to %q", fixed),
+				TextEdits: NewMessage(key, lit).Edits(Replacement{New: fixed, End: len(key)}),
+			}}
+		}
+	}
+	return d, true
+}
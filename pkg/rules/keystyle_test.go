@@ -0,0 +1,42 @@
+package rules
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/AlexanderGhosty/log-linter/pkg/logsupport"
+)
+
+func TestKeyStyle_Name(t *testing.T) {
+	r := NewKeyStyle(logsupport.NewRegistry(nil), "", "")
+	if r.Name() != "key-style" {
+		t.Errorf("expected name 'key-style', got %q", r.Name())
+	}
+	if diags := r.Check(Message{Text: "userId"}); len(diags) > 0 {
+		t.Errorf("Check reported messages: %v", diags)
+	}
+}
+
+func TestKeyStyle_Pattern(t *testing.T) {
+	r := NewKeyStyle(logsupport.NewRegistry(nil), "regex", "[a-z]+").(*KeyStyle)
+
+	tests := []struct {
+		key  string
+		want bool
+	}{
+		{key: "user", want: false},
+		{key: "userID", want: true},
+		{key: "User-Name", want: true},
+		{key: "user_id", want: true},
+	}
+
+	for _, tt := range tests {
+		d, got := r.checkKey(tt.key, &ast.BasicLit{})
+		if got != tt.want {
+			t.Errorf("checkKey(%q) reported = %v, want %v", tt.key, got, tt.want)
+		}
+		if got && d.Message != `log field key "`+tt.key+`" should match pattern [a-z]+` {
+			t.Errorf("checkKey(%q) message = %q", tt.key, d.Message)
+		}
+	}
+}
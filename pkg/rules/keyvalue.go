@@ -0,0 +1,101 @@
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/types"
+
+	"github.com/AlexanderGhosty/log-linter/pkg/logsupport"
+	"golang.org/x/tools/go/analysis"
+)
+
+// KeyValue checks that the key-value arguments of slog calls and zap's
+// sugared "w" methods pair up: keys are strings, every key has a value and
+// attributes (slog.Attr, zap.Field) are not used as values.
+type KeyValue struct {
+	registry *logsupport.Registry
+}
+
+// NewKeyValue creates a new KeyValue rule.
+func NewKeyValue(registry *logsupport.Registry) Rule {
+	if registry == nil {
+		registry = logsupport.NewRegistry(nil)
+	}
+
+	return &KeyValue{registry: registry}
+}
+
+// Name returns the name of the rule.
+func (r *KeyValue) Name() string {
+	return "key-value"
+}
+
+// Check does nothing: the rule only applies to key-value arguments.
+func (r *KeyValue) Check(Message) []analysis.Diagnostic {
+	return nil
+}
+
+// CheckCall analyzes the key-value arguments of a log call.
+func (r *KeyValue) CheckCall(call *ast.CallExpr, pass *analysis.Pass) []analysis.Diagnostic {
+	lc, ok := r.registry.Resolve(pass, call)
+	if !ok || (lc.UserType != "slog" && lc.UserType != "zap") {
+		return nil
+	}
+
+	attr, consequence := "slog.Attr", "logged as !BADKEY"
+	if lc.UserType == "zap" {
+		attr, consequence = "zap.Field", "ignored"
+	}
+	qualifier := func(pkg *types.Package) string {
+		if pkg == pass.Pkg {
+			return ""
+		}
+		return pkg.Name()
+	}
+
+	var diags []analysis.Diagnostic
+	report := func(node ast.Node, format string, args ...any) {
+		diags = append(diags, analysis.Diagnostic{
+			Pos:     node.Pos(),
+			End:     node.End(),
+			Message: fmt.Sprintf(format, args...),
+		})
+	}
+
+	var key ast.Expr
+	for _, kv := range logsupport.PairKeyValues(pass, lc, call) {
+		t := pass.TypesInfo.TypeOf(kv.Expr)
+		switch kv.Kind {
+		case logsupport.ArgKey:
+			key = kv.Expr
+		case logsupport.ArgValue:
+			if key != nil && logsupport.IsAttrType(t, lc.UserType) {
+				report(kv.Expr, "%s %s is used as the value of key %s, which breaks key-value pairing",
+					attr, types.ExprString(kv.Expr), describeKey(pass, key))
+			}
+			key = nil
+		case logsupport.ArgMissingValue:
+			// Values of interface type may hold an attribute at run time.
+			if t != nil && !types.IsInterface(t) {
+				report(kv.Expr, "odd number of key-value arguments: key %s has no value (%s)",
+					describeKey(pass, kv.Expr), consequence)
+			}
+		case logsupport.ArgBadKey:
+			if t != nil {
+				report(kv.Expr, "log key %s has type %s, not string (%s)",
+					types.ExprString(kv.Expr), types.TypeString(t, qualifier), consequence)
+			}
+		}
+	}
+
+	return diags
+}
+
+// describeKey returns the value of a constant key, or else its expression.
+func describeKey(pass *analysis.Pass, key ast.Expr) string {
+	if tv, ok := pass.TypesInfo.Types[key]; ok && tv.Value != nil && tv.Value.Kind() == constant.String {
+		return fmt.Sprintf("%q", constant.StringVal(tv.Value))
+	}
+	return types.ExprString(key)
+}
@@ -0,0 +1,17 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/AlexanderGhosty/log-linter/pkg/logsupport"
+)
+
+func TestKeyValue_Name(t *testing.T) {
+	r := NewKeyValue(logsupport.NewRegistry(nil))
+	if r.Name() != "key-value" {
+		t.Errorf("expected name 'key-value', got %q", r.Name())
+	}
+	if diags := r.Check(Message{Text: "user"}); len(diags) > 0 {
+		t.Errorf("Check reported messages: %v", diags)
+	}
+}
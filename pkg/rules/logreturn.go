@@ -0,0 +1,215 @@
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"strings"
+
+	"github.com/AlexanderGhosty/log-linter/pkg/errflow"
+	"github.com/AlexanderGhosty/log-linter/pkg/logsupport"
+	"github.com/AlexanderGhosty/log-linter/pkg/ssautil"
+	"golang.org/x/tools/go/analysis"
+)
+
+// Values of the error levels of the supported loggers: slog.LevelError,
+// zerolog.ErrorLevel and logrus.ErrorLevel. Higher slog and zerolog levels,
+// and lower logrus levels, are more severe.
+const (
+	slogLevelError    = 8
+	zerologLevelError = 3
+	logrusLevelError  = 2
+)
+
+// LogReturn checks for errors that are logged at error level and also
+// returned, as is or wrapped, on the same path, as tracked by the errflow
+// analyzer (see package errflow), e.g.
+//
+//	if err != nil {
+//		slog.Error("failed", "error", err)
+//		return err
+//	}
+//
+// The caller is likely to log the error again, so that it is reported at
+// every layer it passes through.
+type LogReturn struct {
+	registry *logsupport.Registry
+	analyzer *analysis.Analyzer
+}
+
+// NewLogReturn creates a new LogReturn rule reading the results of the given
+// errflow analyzer, which must be among the requirements of the running
+// analyzer.
+func NewLogReturn(registry *logsupport.Registry, analyzer *analysis.Analyzer) Rule {
+	if registry == nil {
+		registry = logsupport.NewRegistry(nil)
+	}
+
+	return &LogReturn{registry: registry, analyzer: analyzer}
+}
+
+// Name returns the name of the rule.
+func (r *LogReturn) Name() string {
+	return "log-and-return"
+}
+
+// Check does nothing: constant messages contain no errors.
+func (r *LogReturn) Check(Message) []analysis.Diagnostic {
+	return nil
+}
+
+// CheckCall reports the errors logged by an error-level log call that are
+// returned after it.
+func (r *LogReturn) CheckCall(call *ast.CallExpr, pass *analysis.Pass) []analysis.Diagnostic {
+	res, _ := pass.ResultOf[r.analyzer].(*errflow.Result)
+	if res == nil {
+		return nil
+	}
+	lc, ok := r.registry.Resolve(pass, call)
+	if !ok || !errorLevel(pass, lc, call) {
+		return nil
+	}
+	if err := res.Err(); err != nil {
+		return []analysis.Diagnostic{unchecked(pass, call, "error flow", err)}
+	}
+
+	var diags []analysis.Diagnostic
+	seen := make(map[string]bool)
+	for _, err := range loggedErrors(pass, call) {
+		name := types.ExprString(err)
+		if seen[name] {
+			continue
+		}
+		ret, ok := res.Returned(call, err)
+		if !ok {
+			continue
+		}
+		seen[name] = true
+		diags = append(diags, analysis.Diagnostic{
+			Pos:     err.Pos(),
+			End:     err.End(),
+			Message: fmt.Sprintf("error %s is logged and returned; either handle it here or return it without logging", name),
+			Related: []analysis.RelatedInformation{{Pos: ret, Message: fmt.Sprintf("%s is returned here", name)}},
+		})
+	}
+	return diags
+}
+
+// errorLevel reports whether a log call logs at error level: its function
+// starts with "Error" (slog.ErrorContext, zap's Errorw, logrus' Errorf), it
+// is a slog Log or LogAttrs call with a constant level of at least
+// slog.LevelError or a logrus Log, Logf or Logln call with a constant level
+// of at most logrus.ErrorLevel, or it ends a zerolog chain started at error
+// level.
+func errorLevel(pass *analysis.Pass, lc logsupport.Call, call *ast.CallExpr) bool {
+	if strings.HasPrefix(lc.Func, "Error") {
+		return true
+	}
+
+	switch lc.UserType {
+	case "slog":
+		if lc.Func != "Log" && lc.Func != "LogAttrs" {
+			return false
+		}
+		level, ok := levelArg(pass, call, lc.MessageIndex-1)
+		return ok && level >= slogLevelError
+	case "logrus":
+		if lc.Func != "Log" && lc.Func != "Logf" && lc.Func != "Logln" {
+			return false
+		}
+		level, ok := levelArg(pass, call, lc.MessageIndex-1)
+		return ok && level <= logrusLevelError
+	case "zerolog":
+		return zerologErrorLevel(pass, call)
+	}
+	return false
+}
+
+// zerologErrorLevel reports whether call ends a zerolog chain whose event is
+// started at error level, by the Error method, by the Err method (at error
+// level for the non-nil errors logged on an error path) or by the WithLevel
+// method with a constant level of at least zerolog.ErrorLevel, e.g.
+// log.Err(err).Str("user", id).Msg("failed").
+func zerologErrorLevel(pass *analysis.Pass, call *ast.CallExpr) bool {
+	for expr := call.Fun; ; {
+		sel, ok := expr.(*ast.SelectorExpr)
+		if !ok {
+			return false
+		}
+		inner, ok := sel.X.(*ast.CallExpr)
+		if !ok {
+			return false
+		}
+		fn, ok := inner.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return false
+		}
+		if !isZerologEvent(pass.TypesInfo.TypeOf(fn.X)) {
+			// A Logger method or a zerolog/log function starts the event.
+			switch fn.Sel.Name {
+			case "Error":
+				return len(inner.Args) == 0
+			case "Err":
+				return len(inner.Args) == 1
+			case "WithLevel":
+				level, ok := levelArg(pass, inner, 0)
+				return ok && level >= zerologLevelError
+			}
+			return false
+		}
+		expr = inner.Fun
+	}
+}
+
+// isZerologEvent reports whether t is *zerolog.Event, the type of the
+// events that zerolog chains are built on.
+func isZerologEvent(t types.Type) bool {
+	ptr, ok := t.(*types.Pointer)
+	if !ok {
+		return false
+	}
+	named, ok := ptr.Elem().(*types.Named)
+	return ok && named.Obj().Name() == "Event" && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == "github.com/rs/zerolog"
+}
+
+// levelArg returns the value of the argument of call at index i, if it is
+// an integer constant.
+func levelArg(pass *analysis.Pass, call *ast.CallExpr, i int) (int64, bool) {
+	if i < 0 || i >= len(call.Args) {
+		return 0, false
+	}
+	tv, ok := pass.TypesInfo.Types[call.Args[i]]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.Int {
+		return 0, false
+	}
+	return constant.Int64Val(tv.Value)
+}
+
+// loggedErrors returns the errors logged by a call: its arguments, or parts
+// of them (as err in err.Error()), of a type implementing error, including
+// those of the calls it is chained to, as in logrus.WithError(err).Error(msg).
+func loggedErrors(pass *analysis.Pass, call *ast.CallExpr) []ast.Expr {
+	var errs []ast.Expr
+	visit := func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case ast.Expr:
+			tv, ok := pass.TypesInfo.Types[n]
+			if ok && tv.IsValue() && ssautil.IsError(tv.Type) {
+				errs = append(errs, ast.Unparen(n))
+				return false
+			}
+		}
+		return true
+	}
+
+	if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
+		ast.Inspect(sel.X, visit)
+	}
+	for _, arg := range call.Args {
+		ast.Inspect(arg, visit)
+	}
+	return errs
+}
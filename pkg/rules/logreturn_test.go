@@ -0,0 +1,18 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/AlexanderGhosty/log-linter/pkg/errflow"
+	"github.com/AlexanderGhosty/log-linter/pkg/logsupport"
+)
+
+func TestLogReturn_Name(t *testing.T) {
+	r := NewLogReturn(logsupport.NewRegistry(nil), errflow.New(nil, nil))
+	if r.Name() != "log-and-return" {
+		t.Errorf("expected name 'log-and-return', got %q", r.Name())
+	}
+	if diags := r.Check(Message{Text: "failed to load user"}); len(diags) > 0 {
+		t.Errorf("Check reported constant messages: %v", diags)
+	}
+}
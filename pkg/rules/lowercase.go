@@ -2,9 +2,8 @@ package rules
 
 import (
 	"fmt"
-	"go/token"
-	"strconv"
 	"unicode"
+	"unicode/utf8"
 
 	"golang.org/x/tools/go/analysis"
 )
@@ -23,31 +22,32 @@ func (r *Lowercase) Name() string {
 }
 
 // Check validates a single log message string.
-func (r *Lowercase) Check(msg string, pos, end token.Pos) []analysis.Diagnostic {
-	if msg == "" {
-		return nil
-	}
-
-	runes := []rune(msg)
-	firstRune := runes[0]
-	if !unicode.IsUpper(firstRune) {
+func (r *Lowercase) Check(msg Message) []analysis.Diagnostic {
+	firstRune, size := utf8.DecodeRuneInString(msg.Text)
+	if size == 0 || !unicode.IsUpper(firstRune) {
 		return nil
 	}
 
 	// Suggest replacement: lowercased first letter
-	newMsg := string(unicode.ToLower(firstRune)) + string(runes[1:])
+	repl := Replacement{Start: 0, End: size, New: string(unicode.ToLower(firstRune))}
+	pos, end := msg.Range(0, size)
 
 	return []analysis.Diagnostic{{
 		Pos:     pos,
 		End:     end,
 		Message: "log message should start with a lowercase letter",
 		SuggestedFixes: []analysis.SuggestedFix{{
-			Message: fmt.Sprintf("change to %q", newMsg),
-			TextEdits: []analysis.TextEdit{{
-				Pos:     pos,
-				End:     end,
-				NewText: []byte(strconv.Quote(newMsg)),
-			}},
+			Message:   fmt.Sprintf("change to %q", msg.Apply(repl)),
+			TextEdits: msg.Edits(repl),
 		}},
 	}}
 }
+
+// CheckFragment validates a fragment of a message built at run time; only the
+// start of the message must be lowercase.
+func (r *Lowercase) CheckFragment(fragment Message, start bool) []analysis.Diagnostic {
+	if !start {
+		return nil
+	}
+	return r.Check(fragment)
+}
@@ -1,7 +1,6 @@
 package rules
 
 import (
-	"go/token"
 	"testing"
 )
 
@@ -33,7 +32,7 @@ func TestLowercase_Check(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			diags := r.Check(tt.msg, token.NoPos, token.NoPos)
+			diags := r.Check(Message{Text: tt.msg})
 			gotDiag := len(diags) > 0
 			if gotDiag != tt.wantDiag {
 				t.Errorf("Check(%q): got diagnostic=%v, want diagnostic=%v", tt.msg, gotDiag, tt.wantDiag)
@@ -47,7 +46,7 @@ func TestLowercase_Check(t *testing.T) {
 
 func TestLowercase_SuggestedFix(t *testing.T) {
 	r := NewLowercase()
-	diags := r.Check("Hello world", token.NoPos, token.NoPos)
+	diags := r.Check(Message{Text: "Hello world"})
 
 	if len(diags) == 0 {
 		t.Fatal("expected diagnostic")
@@ -67,3 +66,14 @@ func TestLowercase_SuggestedFix(t *testing.T) {
 		t.Errorf("expected fix %q, got %q", `"hello world"`, newText)
 	}
 }
+
+func TestLowercase_CheckFragment(t *testing.T) {
+	r := NewLowercase().(FragmentRule)
+
+	if diags := r.CheckFragment(Message{Text: "Started "}, true); len(diags) == 0 {
+		t.Error("expected diagnostic for the start of the message")
+	}
+	if diags := r.CheckFragment(Message{Text: "Started"}, false); len(diags) > 0 {
+		t.Errorf("unexpected diagnostic for a later fragment: %q", diags[0].Message)
+	}
+}
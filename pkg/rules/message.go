@@ -0,0 +1,86 @@
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+
+	"github.com/AlexanderGhosty/log-linter/pkg/utils"
+	"golang.org/x/tools/go/analysis"
+)
+
+// Message is the text of a constant log message, a fragment of one or an
+// attribute key, and the expression it is written as.
+type Message struct {
+	// lit maps Text to its source if the expression is a string literal.
+	lit  *utils.Literal
+	Text string
+	Pos  token.Pos
+	End  token.Pos
+}
+
+// NewMessage returns the message text written as expr. If expr is a string
+// literal, diagnostics and fixes address the exact bytes concerned (see
+// Range and Edits); otherwise they cover all of expr.
+func NewMessage(text string, expr ast.Expr) Message {
+	m := Message{Text: text, Pos: expr.Pos(), End: expr.End()}
+	if bl, ok := ast.Unparen(expr).(*ast.BasicLit); ok {
+		if lit, ok := utils.ParseLiteral(bl); ok && lit.Value() == text {
+			m.lit = lit
+		}
+	}
+	return m
+}
+
+// Range returns the source range of the bytes [start, end) of the text.
+func (m Message) Range(start, end int) (token.Pos, token.Pos) {
+	if m.lit == nil {
+		return m.Pos, m.End
+	}
+	return m.lit.Range(start, end)
+}
+
+// Replacement replaces the bytes [Start, End) of a message text with New.
+type Replacement struct {
+	New        string
+	Start, End int
+}
+
+// Apply returns the text with the replacements, sorted by position and not
+// overlapping, applied.
+func (m Message) Apply(repls ...Replacement) string {
+	var b []byte
+	last := 0
+	for _, r := range repls {
+		b = append(b, m.Text[last:r.Start]...)
+		b = append(b, r.New...)
+		last = r.End
+	}
+	return string(append(b, m.Text[last:]...))
+}
+
+// Edits returns the text edits that apply the replacements (see Apply). Only
+// the replaced bytes of a string literal are rewritten, keeping its quotes and
+// escape sequences; if that is not possible, the whole expression is replaced
+// with the quoted new text.
+func (m Message) Edits(repls ...Replacement) []analysis.TextEdit {
+	if m.lit != nil {
+		edits := make([]analysis.TextEdit, 0, len(repls))
+		for _, r := range repls {
+			edit, ok := m.lit.Edit(r.Start, r.End, r.New)
+			if !ok {
+				edits = nil
+				break
+			}
+			edits = append(edits, edit)
+		}
+		if edits != nil {
+			return edits
+		}
+	}
+	return []analysis.TextEdit{{
+		Pos:     m.Pos,
+		End:     m.End,
+		NewText: []byte(strconv.Quote(m.Apply(repls...))),
+	}}
+}
@@ -0,0 +1,92 @@
+package rules
+
+import (
+	"go/parser"
+	"testing"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// sourceMessage parses src, a string literal with the value text, as a
+// message. The literal starts at Pos 1, so offsets in src are Pos - 1.
+func sourceMessage(t *testing.T, src, text string) Message {
+	t.Helper()
+	expr, err := parser.ParseExpr(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewMessage(text, expr)
+}
+
+// applyEdits returns src with the edits of the first fix of d applied.
+func applyEdits(src string, d analysis.Diagnostic) string {
+	edits := d.SuggestedFixes[0].TextEdits
+	for i := len(edits) - 1; i >= 0; i-- {
+		e := edits[i]
+		src = src[:e.Pos-1] + string(e.NewText) + src[e.End-1:]
+	}
+	return src
+}
+
+func TestMessage_Diagnostics(t *testing.T) {
+	tests := []struct {
+		rule      Rule
+		src       string
+		text      string
+		wantRange string // source text of the diagnostic
+		wantFix   string // source after the fix, if any
+	}{
+		{
+			rule: NewLowercase(), src: "`Raw \"quoted\"`", text: `Raw "quoted"`,
+			wantRange: "R", wantFix: "`raw \"quoted\"`",
+		},
+		{
+			rule: NewSymbols(nil, ""), src: `"done!\tnow 🚀"`, text: "done!\tnow 🚀",
+			wantRange: `!\tnow 🚀`, wantFix: `"donenow "`,
+		},
+		{
+			rule: NewSymbols(nil, ""), src: `"\x41\x42 ok!"`, text: "AB ok!",
+			wantRange: "!", wantFix: `"\x41\x42 ok"`,
+		},
+		{
+			rule: NewEnglish(nil, EnglishOptions{}, nil), src: `"user вход\tдан ok"`, text: "user вход\tдан ok",
+			wantRange: `вход\tдан`,
+		},
+		{
+			rule: NewSensitive(nil, nil, nil, nil, ""), src: `"reset Password now"`, text: "reset Password now",
+			wantRange: "Password",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.src, func(t *testing.T) {
+			diags := tt.rule.Check(sourceMessage(t, tt.src, tt.text))
+			if len(diags) != 1 {
+				t.Fatalf("got %d diagnostics, want 1", len(diags))
+			}
+			d := diags[0]
+			if got := tt.src[d.Pos-1 : d.End-1]; got != tt.wantRange {
+				t.Errorf("diagnostic range = %q, want %q", got, tt.wantRange)
+			}
+			if tt.wantFix == "" {
+				return
+			}
+			if got := applyEdits(tt.src, d); got != tt.wantFix {
+				t.Errorf("fixed source = %s, want %s", got, tt.wantFix)
+			}
+		})
+	}
+}
+
+func TestMessage_EditsFallback(t *testing.T) {
+	// A raw string cannot hold a backquote: the whole literal is replaced.
+	const src = "`Say hi`"
+	m := sourceMessage(t, src, "Say hi")
+	edits := m.Edits(Replacement{New: "`", Start: 3, End: 4})
+	if len(edits) != 1 || edits[0].Pos != m.Pos || edits[0].End != m.End {
+		t.Fatalf("Edits() = %+v, want a single edit of the whole literal", edits)
+	}
+	if got := string(edits[0].NewText); got != "\"Say`hi\"" {
+		t.Errorf("NewText = %s, want %s", got, "\"Say`hi\"")
+	}
+}
@@ -0,0 +1,81 @@
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+
+	"github.com/AlexanderGhosty/log-linter/pkg/logsupport"
+	"github.com/AlexanderGhosty/log-linter/pkg/utils"
+	"golang.org/x/tools/go/analysis"
+)
+
+// Printf checks that formatting directives are only used with printf-style log methods.
+type Printf struct {
+	registry *logsupport.Registry
+}
+
+// NewPrintf creates a new Printf rule.
+func NewPrintf(registry *logsupport.Registry) Rule {
+	if registry == nil {
+		registry = logsupport.NewRegistry(nil)
+	}
+
+	return &Printf{
+		registry: registry,
+	}
+}
+
+// Name returns the name of the rule.
+func (r *Printf) Name() string {
+	return "printf"
+}
+
+// Check validates a single log message string.
+// Whether a directive is allowed depends on the called method, so all work is done in CheckCall.
+func (r *Printf) Check(Message) []analysis.Diagnostic {
+	return nil
+}
+
+// CheckCall analyzes a full log call expression.
+func (r *Printf) CheckCall(call *ast.CallExpr, pass *analysis.Pass) []analysis.Diagnostic {
+	lc, ok := r.registry.Resolve(pass, call)
+	if !ok || lc.Printf {
+		return nil
+	}
+
+	msgIndex := lc.MessageIndex
+	if msgIndex < 0 || msgIndex >= len(call.Args) {
+		return nil
+	}
+
+	arg := call.Args[msgIndex]
+	tv, ok := pass.TypesInfo.Types[arg]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return nil
+	}
+
+	msg := NewMessage(constant.StringVal(tv.Value), arg)
+	start, end := utils.IndexFormatDirective(msg.Text)
+	if start < 0 {
+		return nil
+	}
+
+	pos, endPos := msg.Range(start, end)
+	return []analysis.Diagnostic{{
+		Pos:     pos,
+		End:     endPos,
+		Message: fmt.Sprintf("log message contains formatting directive %q but %s is not a printf-style method", msg.Text[start:end], calleeName(call)),
+	}}
+}
+
+// calleeName returns the name of the called function as written at the call site.
+func calleeName(call *ast.CallExpr) string {
+	switch fun := call.Fun.(type) {
+	case *ast.SelectorExpr:
+		return fun.Sel.Name
+	case *ast.Ident:
+		return fun.Name
+	}
+	return "function"
+}
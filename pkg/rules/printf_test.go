@@ -0,0 +1,14 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/AlexanderGhosty/log-linter/pkg/logsupport"
+)
+
+func TestPrintf_Name(t *testing.T) {
+	r := NewPrintf(logsupport.NewRegistry(nil))
+	if r.Name() != "printf" {
+		t.Errorf("expected name 'printf', got %q", r.Name())
+	}
+}
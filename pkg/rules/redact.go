@@ -0,0 +1,72 @@
+package rules
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// redactedValue replaces sensitive values when no redaction function is configured.
+const redactedValue = `"[REDACTED]"`
+
+// redaction builds the suggested fixes that redact sensitive attribute values,
+// either by wrapping them in a configured function (e.g. redact.String(v)) or
+// by replacing them with "[REDACTED]".
+type redaction struct {
+	pkgPath string
+	name    string
+}
+
+// newRedaction parses the fully qualified name of a redaction function, e.g.
+// "example.com/redact.String". An empty name selects the "[REDACTED]"
+// replacement.
+func newRedaction(fn string) redaction {
+	dot := strings.LastIndex(fn, ".")
+	if dot < 0 {
+		return redaction{}
+	}
+	return redaction{pkgPath: fn[:dot], name: fn[dot+1:]}
+}
+
+// fix returns a fix redacting the attribute value expr, or nil if expr is not
+// a string or the redaction function cannot be referenced at expr.
+func (rd redaction) fix(pass *analysis.Pass, expr ast.Expr) *analysis.SuggestedFix {
+	t := pass.TypesInfo.TypeOf(expr)
+	if t == nil {
+		return nil
+	}
+	basic, ok := t.Underlying().(*types.Basic)
+	if !ok || basic.Info()&types.IsString == 0 {
+		return nil
+	}
+
+	if rd.name == "" {
+		return &analysis.SuggestedFix{
+			Message: "replace with " + redactedValue,
+			TextEdits: []analysis.TextEdit{{
+				Pos:     expr.Pos(),
+				End:     expr.End(),
+				NewText: []byte(redactedValue),
+			}},
+		}
+	}
+
+	// The function takes a string, so other string types would not compile.
+	if !types.Identical(types.Default(t), types.Typ[types.String]) {
+		return nil
+	}
+	qualifier, edits, ok := importQualifier(pass, rd.pkgPath, expr.Pos())
+	if !ok {
+		return nil
+	}
+	fn := qualifier + rd.name
+	return &analysis.SuggestedFix{
+		Message: "wrap in " + fn,
+		TextEdits: append(edits,
+			analysis.TextEdit{Pos: expr.Pos(), End: expr.Pos(), NewText: []byte(fn + "(")},
+			analysis.TextEdit{Pos: expr.End(), End: expr.End(), NewText: []byte(")")},
+		),
+	}
+}
@@ -2,7 +2,6 @@ package rules
 
 import (
 	"go/ast"
-	"go/token"
 
 	"golang.org/x/tools/go/analysis"
 )
@@ -13,7 +12,7 @@ type Rule interface {
 	Name() string
 
 	// Check validates the log message and returns diagnostics if violated.
-	Check(msg string, pos, end token.Pos) []analysis.Diagnostic
+	Check(msg Message) []analysis.Diagnostic
 }
 
 // ExprRule is an optional interface for rules that need
@@ -23,3 +22,27 @@ type ExprRule interface {
 	Rule
 	CheckCall(call *ast.CallExpr, pass *analysis.Pass) []analysis.Diagnostic
 }
+
+// FormatRule is an optional interface for rules that need to treat
+// printf-style format strings differently from plain messages
+// (e.g. skipping over %v verbs).
+type FormatRule interface {
+	Rule
+	CheckFormat(format Message) []analysis.Diagnostic
+}
+
+// FragmentRule is an optional interface for rules that check the constant
+// fragments of a message built at run time (e.g. "user " + name)
+// differently from complete messages. start is true for the fragment the
+// message starts with.
+type FragmentRule interface {
+	Rule
+	CheckFragment(fragment Message, start bool) []analysis.Diagnostic
+}
+
+// PackageRule is an optional interface for rules whose settings depend on
+// the package being analyzed (e.g. per-package overrides).
+type PackageRule interface {
+	Rule
+	ForPackage(pkgPath string) Rule
+}
@@ -0,0 +1,91 @@
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+
+	"github.com/AlexanderGhosty/log-linter/pkg/logsupport"
+	"github.com/AlexanderGhosty/log-linter/pkg/utils"
+	"golang.org/x/tools/go/analysis"
+)
+
+// Default thresholds for high-entropy strings.
+const (
+	defaultSecretMinEntropy = 4.0
+	defaultSecretMinLength  = 20
+)
+
+// Secrets checks for hard-coded secrets (JWTs, AWS keys, PEM blocks, URLs
+// with credentials, bearer tokens and other high-entropy strings) in
+// constant log messages and constant attribute values.
+type Secrets struct {
+	registry   *logsupport.Registry
+	minEntropy float64
+	minLength  int
+}
+
+// NewSecrets creates a new Secrets rule. Zero thresholds select the defaults.
+func NewSecrets(registry *logsupport.Registry, minEntropy float64, minLength int) Rule {
+	if registry == nil {
+		registry = logsupport.NewRegistry(nil)
+	}
+	if minEntropy == 0 {
+		minEntropy = defaultSecretMinEntropy
+	}
+	if minLength == 0 {
+		minLength = defaultSecretMinLength
+	}
+
+	return &Secrets{
+		registry:   registry,
+		minEntropy: minEntropy,
+		minLength:  minLength,
+	}
+}
+
+// Name returns the name of the rule.
+func (r *Secrets) Name() string {
+	return "secrets"
+}
+
+// Check validates a single log message string.
+func (r *Secrets) Check(msg Message) []analysis.Diagnostic {
+	return r.check(msg, "log message contains a possible secret (%s)")
+}
+
+func (r *Secrets) check(msg Message, format string) []analysis.Diagnostic {
+	kind, start, end := utils.FindSecret(msg.Text, r.minEntropy, r.minLength)
+	if kind == "" {
+		return nil
+	}
+	pos, endPos := msg.Range(start, end)
+	return []analysis.Diagnostic{{
+		Pos:     pos,
+		End:     endPos,
+		Message: fmt.Sprintf(format, kind),
+	}}
+}
+
+// CheckCall analyzes the constant attribute values of a log call.
+func (r *Secrets) CheckCall(call *ast.CallExpr, pass *analysis.Pass) []analysis.Diagnostic {
+	lc, ok := r.registry.Resolve(pass, call)
+	if !ok {
+		return nil
+	}
+
+	var diags []analysis.Diagnostic
+	r.registry.InspectLogArgs(pass, call, lc.MessageIndex, func(arg ast.Expr, isKey bool) {
+		if isKey {
+			return
+		}
+		tv, ok := pass.TypesInfo.Types[arg]
+		if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+			return
+		}
+		msg := NewMessage(constant.StringVal(tv.Value), arg)
+		diags = append(diags, r.check(msg, "log attribute value contains a possible secret (%s)")...)
+	})
+
+	return diags
+}
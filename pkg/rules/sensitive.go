@@ -5,6 +5,7 @@ import (
 	"go/ast"
 	"go/constant"
 	"go/token"
+	"go/types"
 	"regexp"
 	"strconv"
 	"strings"
@@ -20,28 +21,22 @@ import (
 type Sensitive struct {
 	registry *logsupport.Registry
 	cache    map[string]bool
+	// types holds the fully qualified names of sensitive named types.
+	types    map[string]bool
+	redact   redaction
 	keywords []string
 	patterns []*regexp.Regexp
 	mu       sync.RWMutex
 }
 
-// NewSensitive creates a new Sensitive rule.
-func NewSensitive(registry *logsupport.Registry, keywords []string, patterns []string) Rule {
-	if keywords == nil {
-		keywords = []string{
-			"password", "passwd", "secret", "token",
-			"api_key", "apikey", "access_key", "auth_token",
-			"credential", "private_key",
-		}
-	}
-	normalized := make([]string, 0, len(keywords))
-	for _, kw := range keywords {
-		kw = strings.ToLower(strings.TrimSpace(kw))
-		if kw == "" {
-			continue
-		}
-		normalized = append(normalized, kw)
-	}
+// NewSensitive creates a new Sensitive rule. Values of the given fully
+// qualified named types (e.g. "example.com/auth.Token") are reported when
+// logged, as are values whose type contains them. Sensitive string attribute
+// values come with a fix that wraps them in the redact function (e.g.
+// "example.com/redact.String"), or replaces them with "[REDACTED]" if it is
+// empty.
+func NewSensitive(registry *logsupport.Registry, keywords []string, patterns []string, sensitiveTypes []string, redact string) Rule {
+	normalized := SensitiveKeywords(keywords)
 
 	compiledPatterns := make([]*regexp.Regexp, 0, len(patterns))
 	for _, p := range patterns {
@@ -58,7 +53,14 @@ func NewSensitive(registry *logsupport.Registry, keywords []string, patterns []s
 		registry = logsupport.NewRegistry(nil)
 	}
 
+	typeSet := make(map[string]bool, len(sensitiveTypes))
+	for _, t := range sensitiveTypes {
+		typeSet[t] = true
+	}
+
 	return &Sensitive{
+		types:    typeSet,
+		redact:   newRedaction(redact),
 		keywords: normalized,
 		patterns: compiledPatterns,
 		registry: registry,
@@ -66,14 +68,36 @@ func NewSensitive(registry *logsupport.Registry, keywords []string, patterns []s
 	}
 }
 
+// SensitiveKeywords returns the configured keywords in lowercase, without
+// empty entries, or the default keywords if none are configured.
+func SensitiveKeywords(keywords []string) []string {
+	if keywords == nil {
+		keywords = []string{
+			"password", "passwd", "secret", "token",
+			"api_key", "apikey", "access_key", "auth_token",
+			"credential", "private_key",
+		}
+	}
+	normalized := make([]string, 0, len(keywords))
+	for _, kw := range keywords {
+		kw = strings.ToLower(strings.TrimSpace(kw))
+		if kw == "" {
+			continue
+		}
+		normalized = append(normalized, kw)
+	}
+	return normalized
+}
+
 // Name returns the name of the rule.
 func (r *Sensitive) Name() string {
 	return "sensitive"
 }
 
 // Check validates a single log message string.
-func (r *Sensitive) Check(msg string, pos, end token.Pos) []analysis.Diagnostic {
-	if r.containsSensitiveInfo(msg) {
+func (r *Sensitive) Check(msg Message) []analysis.Diagnostic {
+	if r.containsSensitiveInfo(msg.Text) {
+		pos, end := msg.Range(r.sensitiveRange(msg.Text))
 		return []analysis.Diagnostic{{
 			Pos:     pos,
 			End:     end,
@@ -97,44 +121,147 @@ func (r *Sensitive) CheckCall(call *ast.CallExpr, pass *analysis.Pass) []analysi
 	}
 
 	// Determine message index to skip it (it is checked by Check method if constant)
-	pkgPath, funcName, ok := utils.ResolveCallPackagePath(pass, call)
+	lc, ok := r.registry.Resolve(pass, call)
 	msgIndex := -1
 	if ok {
-		msgIndex = r.registry.MessageIndex(pkgPath, funcName)
+		msgIndex = lc.MessageIndex
 	}
 
 	// Check the message argument itself if it's NOT a constant string (concatenation etc.)
 	// If it IS a constant string, the basic Check method handles it.
 	if msgIndex >= 0 && msgIndex < len(call.Args) {
 		targetArg := call.Args[msgIndex]
-		tv, ok := pass.TypesInfo.Types[targetArg]
-		if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
-			checkOperand(targetArg, r, report, "log message may contain sensitive data")
+		tv, found := pass.TypesInfo.Types[targetArg]
+		if !found || tv.Value == nil || tv.Value.Kind() != constant.String {
+			r.checkValue(pass, targetArg, report, "log message may contain sensitive data")
 		}
 	}
 
+	if ok && lc.Printf {
+		r.checkFormatArgs(pass, call, msgIndex, report)
+	}
+
+	// Print-style calls (e.g. log.Println("user", name)) write every argument into the message
+	if ok && lc.Print && msgIndex >= 0 {
+		for i := msgIndex + 1; i < len(call.Args); i++ {
+			r.checkValue(pass, call.Args[i], report, "log message may contain sensitive data")
+		}
+	}
+
+	// keyDiag is the index of the diagnostic reported for the key of the
+	// current key-value pair, if any, which the fix redacting its value is
+	// attached to.
+	keyDiag := -1
 	r.registry.InspectLogArgs(pass, call, msgIndex, func(arg ast.Expr, isKey bool) {
 		if isKey {
+			keyDiag = -1
+			n := len(diags)
 			// Check if key is a constant string
 			tv, ok := pass.TypesInfo.Types[arg]
 			if ok && tv.Value != nil && tv.Value.Kind() == constant.String {
 				val := constant.StringVal(tv.Value)
 				if r.containsSensitiveInfo(val) {
-					report(arg.Pos(), arg.End(), "log field key may contain sensitive data")
+					pos, end := NewMessage(val, arg).Range(r.sensitiveRange(val))
+					report(pos, end, "log field key may contain sensitive data")
 				}
 			} else {
 				// Key is not a constant string, analyze the expression
 				checkOperand(arg, r, report, "log attribute contains sensitive data")
 			}
+			if len(diags) > n {
+				keyDiag = n
+			}
 		} else {
 			// Value - check recursively
-			checkOperand(arg, r, report, "log attribute contains sensitive data")
+			n := len(diags)
+			r.checkValue(pass, arg, report, "log attribute contains sensitive data")
+			target := keyDiag
+			if target < 0 && len(diags) > n {
+				target = n
+			}
+			keyDiag = -1
+			if target >= 0 {
+				if fix := r.redact.fix(pass, arg); fix != nil {
+					diags[target].SuggestedFixes = append(diags[target].SuggestedFixes, *fix)
+				}
+			}
 		}
 	})
 
 	return diags
 }
 
+// checkFormatArgs checks the operands of a printf-style log call that are
+// printed (see printedOperands).
+func (r *Sensitive) checkFormatArgs(pass *analysis.Pass, call *ast.CallExpr, msgIndex int, report func(token.Pos, token.Pos, string)) {
+	for _, arg := range printedOperands(pass, call, msgIndex) {
+		r.checkValue(pass, arg, report, "log format argument contains sensitive data")
+	}
+}
+
+// printedOperands returns the operands of a printf-style log call whose value
+// ends up in the message. Operands that are only used by %T or %p (or as '*'
+// width/precision) do not print their value and are skipped; operands not
+// bound to any verb are still printed by fmt as %!(EXTRA ...).
+func printedOperands(pass *analysis.Pass, call *ast.CallExpr, msgIndex int) []ast.Expr {
+	if msgIndex < 0 || msgIndex >= len(call.Args) {
+		return nil
+	}
+	operands := call.Args[msgIndex+1:]
+
+	printed := make([]bool, len(operands))
+	bound := make([]bool, len(operands))
+	tv, ok := pass.TypesInfo.Types[call.Args[msgIndex]]
+	if ok && tv.Value != nil && tv.Value.Kind() == constant.String {
+		for _, v := range utils.ParseFormat(constant.StringVal(tv.Value)) {
+			for _, i := range append(v.StarArgs, v.ArgIndex) {
+				if i >= 0 && i < len(operands) {
+					bound[i] = true
+				}
+			}
+			if v.PrintsOperand() && v.ArgIndex < len(operands) {
+				printed[v.ArgIndex] = true
+			}
+		}
+	} else {
+		// Unknown format: assume every operand is printed.
+		for i := range printed {
+			printed[i] = true
+		}
+	}
+
+	var args []ast.Expr
+	for i, arg := range operands {
+		if printed[i] || !bound[i] {
+			args = append(args, arg)
+		}
+	}
+	return args
+}
+
+// checkValue checks a logged value: its expression with checkOperand and,
+// if that finds nothing, its type (see sensitiveType).
+func (r *Sensitive) checkValue(pass *analysis.Pass, expr ast.Expr, report func(token.Pos, token.Pos, string), stringLiteralMsg string) {
+	found := false
+	checkOperand(expr, r, func(pos, end token.Pos, msg string) {
+		found = true
+		report(pos, end, msg)
+	}, stringLiteralMsg)
+	if found {
+		return
+	}
+
+	qualifier := func(pkg *types.Package) string {
+		if pkg == pass.Pkg {
+			return ""
+		}
+		return pkg.Name()
+	}
+	if reason := r.sensitiveType(pass.TypesInfo.TypeOf(expr), qualifier); reason != "" {
+		report(expr.Pos(), expr.End(), "logged value "+reason)
+	}
+}
+
 func checkOperand(expr ast.Expr, r *Sensitive, report func(token.Pos, token.Pos, string), stringLiteralMsg string) {
 	// Check variable names for sensitive keywords
 	if ident, ok := expr.(*ast.Ident); ok {
@@ -154,7 +281,8 @@ func checkOperand(expr ast.Expr, r *Sensitive, report func(token.Pos, token.Pos,
 	if basicLit, ok := expr.(*ast.BasicLit); ok && basicLit.Kind == token.STRING {
 		val, err := strconv.Unquote(basicLit.Value)
 		if err == nil && r.containsSensitiveInfo(val) {
-			report(basicLit.Pos(), basicLit.End(), stringLiteralMsg)
+			pos, end := NewMessage(val, basicLit).Range(r.sensitiveRange(val))
+			report(pos, end, stringLiteralMsg)
 		}
 	}
 	// Recurse for nested binary expressions (e.g. a + b + c)
@@ -218,3 +346,21 @@ func (r *Sensitive) checkString(s string) bool {
 	}
 	return false
 }
+
+// sensitiveRange returns the byte range of the first pattern match or keyword
+// in s, which contains sensitive info, or all of s if it cannot be located.
+func (r *Sensitive) sensitiveRange(s string) (int, int) {
+	for _, re := range r.patterns {
+		if loc := re.FindStringIndex(s); loc != nil {
+			return loc[0], loc[1]
+		}
+	}
+	for _, kw := range r.keywords {
+		for i := 0; i+len(kw) <= len(s); i++ {
+			if strings.EqualFold(s[i:i+len(kw)], kw) {
+				return i, i + len(kw)
+			}
+		}
+	}
+	return 0, len(s)
+}
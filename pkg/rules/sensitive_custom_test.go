@@ -1,7 +1,6 @@
 package rules
 
 import (
-	"go/token"
 	"testing"
 
 	"github.com/AlexanderGhosty/log-linter/pkg/logsupport"
@@ -13,7 +12,7 @@ func TestSensitive_CustomPatterns(t *testing.T) {
 		`\d{4}-\d{4}-\d{4}-\d{4}`, // Simple CC pattern
 	}
 
-	r := NewSensitive(logsupport.NewRegistry(nil), nil, patterns)
+	r := NewSensitive(logsupport.NewRegistry(nil), nil, patterns, nil, "")
 
 	tests := []struct {
 		name     string
@@ -39,7 +38,7 @@ func TestSensitive_CustomPatterns(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			diags := r.Check(tt.input, token.NoPos, token.NoPos)
+			diags := r.Check(Message{Text: tt.input})
 			gotDiag := len(diags) > 0
 			if gotDiag != tt.wantDiag {
 				t.Errorf("Check(%q) diag = %v, want %v", tt.input, gotDiag, tt.wantDiag)
@@ -1,21 +1,20 @@
 package rules
 
 import (
-	"go/token"
 	"testing"
 
 	"github.com/AlexanderGhosty/log-linter/pkg/logsupport"
 )
 
 func TestSensitive_Name(t *testing.T) {
-	r := NewSensitive(logsupport.NewRegistry(nil), nil, nil)
+	r := NewSensitive(logsupport.NewRegistry(nil), nil, nil, nil, "")
 	if r.Name() != "sensitive" {
 		t.Errorf("expected name 'sensitive', got %q", r.Name())
 	}
 }
 
 func TestSensitive_Check(t *testing.T) {
-	r := NewSensitive(logsupport.NewRegistry(nil), nil, nil)
+	r := NewSensitive(logsupport.NewRegistry(nil), nil, nil, nil, "")
 
 	tests := []struct {
 		name     string
@@ -49,7 +48,7 @@ func TestSensitive_Check(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			diags := r.Check(tt.msg, token.NoPos, token.NoPos)
+			diags := r.Check(Message{Text: tt.msg})
 			gotDiag := len(diags) > 0
 			if gotDiag != tt.wantDiag {
 				t.Errorf("Check(%q): got diagnostic=%v, want diagnostic=%v", tt.msg, gotDiag, tt.wantDiag)
@@ -93,7 +92,7 @@ func TestSensitive_ContainsSensitiveInfo(t *testing.T) {
 
 func TestSensitive_DisableDefaults(t *testing.T) {
 	// Pass empty slice (not nil) to disable defaults
-	r := NewSensitive(logsupport.NewRegistry(nil), []string{}, nil).(*Sensitive)
+	r := NewSensitive(logsupport.NewRegistry(nil), []string{}, nil, nil, "").(*Sensitive)
 
 	// Check if default keywords are gone
 	if len(r.keywords) != 0 {
@@ -0,0 +1,126 @@
+package rules
+
+import (
+	"go/types"
+	"reflect"
+	"strings"
+)
+
+// redactTagKey and redactTagValue mark struct fields that must not be
+// logged, e.g. `log:"redact"`.
+const (
+	redactTagKey   = "log"
+	redactTagValue = "redact"
+)
+
+// sensitiveType reports why values of type t may expose sensitive data when
+// logged: t is (or contains, through pointers, slices, arrays, maps and
+// struct fields) a configured sensitive named type, or a struct field tagged
+// `log:"redact"` or named like a sensitive keyword. Types that control their
+// own log representation (slog.LogValuer, zapcore.ObjectMarshaler) are not
+// inspected. The returned reason is empty if t is not sensitive.
+func (r *Sensitive) sensitiveType(t types.Type, qualifier types.Qualifier) string {
+	return r.walkType(t, qualifier, make(map[types.Type]bool))
+}
+
+func (r *Sensitive) walkType(t types.Type, qualifier types.Qualifier, seen map[types.Type]bool) string {
+	if t == nil || seen[t] {
+		return ""
+	}
+	seen[t] = true
+
+	t = types.Unalias(t)
+	if hasLogMarshaler(t) {
+		return ""
+	}
+	if named, ok := t.(*types.Named); ok && r.types[typeName(named)] {
+		return "has sensitive type " + types.TypeString(t, qualifier)
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Pointer:
+		return r.walkType(u.Elem(), qualifier, seen)
+	case *types.Slice:
+		return r.walkType(u.Elem(), qualifier, seen)
+	case *types.Array:
+		return r.walkType(u.Elem(), qualifier, seen)
+	case *types.Map:
+		if reason := r.walkType(u.Key(), qualifier, seen); reason != "" {
+			return reason
+		}
+		return r.walkType(u.Elem(), qualifier, seen)
+	case *types.Struct:
+		for i := 0; i < u.NumFields(); i++ {
+			field := u.Field(i)
+			if isRedacted(u.Tag(i)) || (r.containsSensitiveInfo(field.Name()) && isPrintable(field.Type())) {
+				return "of type " + types.TypeString(t, qualifier) + " contains sensitive field " + field.Name()
+			}
+			if reason := r.walkType(field.Type(), qualifier, seen); reason != "" {
+				return reason
+			}
+		}
+	}
+	return ""
+}
+
+// typeName returns the fully qualified name of a named type, e.g. "example.com/auth.Token".
+func typeName(named *types.Named) string {
+	obj := named.Obj()
+	if obj.Pkg() == nil {
+		return obj.Name()
+	}
+	return obj.Pkg().Path() + "." + obj.Name()
+}
+
+// isRedacted reports whether a struct tag marks the field as redacted.
+func isRedacted(tag string) bool {
+	value, ok := reflect.StructTag(tag).Lookup(redactTagKey)
+	if !ok {
+		return false
+	}
+	for _, opt := range strings.Split(value, ",") {
+		if strings.TrimSpace(opt) == redactTagValue {
+			return true
+		}
+	}
+	return false
+}
+
+// isPrintable reports whether logging a value of type t prints its contents,
+// unlike functions and channels, which are printed as addresses.
+func isPrintable(t types.Type) bool {
+	switch t.Underlying().(type) {
+	case *types.Signature, *types.Chan:
+		return false
+	}
+	return true
+}
+
+// hasLogMarshaler reports whether t implements slog.LogValuer or
+// zapcore.ObjectMarshaler.
+func hasLogMarshaler(t types.Type) bool {
+	mset := types.NewMethodSet(t)
+	for i := 0; i < mset.Len(); i++ {
+		fn, ok := mset.At(i).Obj().(*types.Func)
+		if !ok {
+			continue
+		}
+		sig := fn.Type().(*types.Signature)
+		switch fn.Name() {
+		case "LogValue":
+			if sig.Params().Len() == 0 && sig.Results().Len() == 1 && isNamedType(sig.Results().At(0).Type(), "log/slog", "Value") {
+				return true
+			}
+		case "MarshalLogObject":
+			if sig.Params().Len() == 1 && isNamedType(sig.Params().At(0).Type(), "go.uber.org/zap/zapcore", "ObjectEncoder") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isNamedType(t types.Type, pkgPath, name string) bool {
+	named, ok := types.Unalias(t).(*types.Named)
+	return ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == pkgPath && named.Obj().Name() == name
+}
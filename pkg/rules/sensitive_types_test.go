@@ -0,0 +1,83 @@
+package rules
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"testing"
+
+	"github.com/AlexanderGhosty/log-linter/pkg/logsupport"
+)
+
+const sensitiveTypesSrc = `package p
+
+type Token string
+
+type User struct {
+	Name     string
+	Password string
+}
+
+type Card struct {
+	Number string ` + "`log:\"omitempty,redact\"`" + `
+}
+
+type Node struct {
+	Next  *Node
+	Value int
+}
+
+type Wallet struct {
+	Cards map[string][]*Card
+}
+
+type Hooks struct {
+	OnToken func()
+	Tokens  chan int
+}
+
+type Holder struct {
+	Tokens []Token
+}
+`
+
+func TestSensitive_SensitiveType(t *testing.T) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "p.go", sensitiveTypesSrc, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkg, err := new(types.Config).Check("p", fset, []*ast.File{file}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := NewSensitive(logsupport.NewRegistry(nil), []string{"password"}, nil, []string{"p.Token"}, "").(*Sensitive)
+	qualifier := types.RelativeTo(pkg)
+
+	tests := []struct {
+		typeName string
+		want     string
+	}{
+		{typeName: "Token", want: "has sensitive type Token"},
+		{typeName: "User", want: "of type User contains sensitive field Password"},
+		{typeName: "Card", want: "of type Card contains sensitive field Number"},
+		{typeName: "Node", want: ""},
+		{typeName: "Wallet", want: "of type Card contains sensitive field Number"},
+		{typeName: "Hooks", want: ""},
+		{typeName: "Holder", want: "has sensitive type Token"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.typeName, func(t *testing.T) {
+			typ := pkg.Scope().Lookup(tt.typeName).Type()
+			if got := r.sensitiveType(typ, qualifier); got != tt.want {
+				t.Errorf("sensitiveType(%s) = %q, want %q", tt.typeName, got, tt.want)
+			}
+			if got := r.sensitiveType(types.NewPointer(typ), qualifier); got != tt.want {
+				t.Errorf("sensitiveType(*%s) = %q, want %q", tt.typeName, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"strconv"
+	"strings"
+
+	"github.com/AlexanderGhosty/log-linter/pkg/logsupport"
+	"github.com/AlexanderGhosty/log-linter/pkg/spelling"
+	"github.com/AlexanderGhosty/log-linter/pkg/utils"
+	"golang.org/x/tools/go/analysis"
+)
+
+// Spelling checks constant log messages and attribute keys for misspelled
+// words (see package spelling), suggesting fixes for unambiguous
+// one-letter typos.
+type Spelling struct {
+	registry *logsupport.Registry
+	dict     *spelling.Dictionary
+}
+
+// NewSpelling creates a new Spelling rule that accepts the embedded English
+// words and the given words.
+func NewSpelling(registry *logsupport.Registry, words []string) Rule {
+	if registry == nil {
+		registry = logsupport.NewRegistry(nil)
+	}
+
+	return &Spelling{
+		registry: registry,
+		dict:     spelling.New(words),
+	}
+}
+
+// Name returns the name of the rule.
+func (r *Spelling) Name() string {
+	return "spelling"
+}
+
+// Check validates a single log message string.
+func (r *Spelling) Check(msg Message) []analysis.Diagnostic {
+	return r.check(msg, msg.Text, "log message")
+}
+
+// CheckFormat validates a printf-style format string, ignoring its verbs.
+func (r *Spelling) CheckFormat(format Message) []analysis.Diagnostic {
+	masked := []byte(format.Text)
+	for _, v := range utils.ParseFormat(format.Text) {
+		for i := v.Start; i < v.End; i++ {
+			masked[i] = ' '
+		}
+	}
+	return r.check(format, string(masked), "log message")
+}
+
+// CheckCall analyzes the constant attribute keys of a log call.
+func (r *Spelling) CheckCall(call *ast.CallExpr, pass *analysis.Pass) []analysis.Diagnostic {
+	lc, ok := r.registry.Resolve(pass, call)
+	if !ok {
+		return nil
+	}
+
+	var diags []analysis.Diagnostic
+	r.registry.InspectLogArgs(pass, call, lc.MessageIndex, func(arg ast.Expr, isKey bool) {
+		if !isKey {
+			return
+		}
+		tv, ok := pass.TypesInfo.Types[arg]
+		if ok && tv.Value != nil && tv.Value.Kind() == constant.String {
+			key := constant.StringVal(tv.Value)
+			diags = append(diags, r.check(NewMessage(key, arg), key, "log field key")...)
+		}
+	})
+
+	return diags
+}
+
+// check reports the misspelled words of msg. Words are looked up in masked,
+// a copy of the text with the parts that are not to be checked replaced by
+// spaces. The diagnostic spans the misspelled words.
+func (r *Spelling) check(msg Message, masked string, what string) []analysis.Diagnostic {
+	var (
+		misspelled  []string
+		repls       []Replacement
+		first, last = -1, -1
+	)
+	for _, span := range spelling.Words(masked) {
+		word := msg.Text[span.Start:span.End]
+		if r.dict.Contains(word) {
+			continue
+		}
+		if first < 0 {
+			first = span.Start
+		}
+		last = span.End
+		suggestions, distance := r.dict.Suggest(word)
+		if len(suggestions) == 0 {
+			misspelled = append(misspelled, strconv.Quote(word))
+			continue
+		}
+		misspelled = append(misspelled, fmt.Sprintf("%q (did you mean %q?)", word, suggestions[0]))
+		// Only an unambiguous one-letter typo is fixed: further words may
+		// well be a different word, that -fix would silently substitute.
+		if distance == 1 && len(suggestions) == 1 {
+			repls = append(repls, Replacement{New: suggestions[0], Start: span.Start, End: span.End})
+		}
+	}
+	if len(misspelled) == 0 {
+		return nil
+	}
+
+	message := what + " contains misspelled word " + misspelled[0]
+	if len(misspelled) > 1 {
+		message = what + " contains misspelled words " + strings.Join(misspelled, ", ")
+	}
+	pos, end := msg.Range(first, last)
+	d := analysis.Diagnostic{
+		Pos:     pos,
+		End:     end,
+		Message: message,
+	}
+	if len(repls) > 0 {
+		d.SuggestedFixes = []analysis.SuggestedFix{{
+			Message:   fmt.Sprintf("change to %q", msg.Apply(repls...)),
+			TextEdits: msg.Edits(repls...),
+		}}
+	}
+	return []analysis.Diagnostic{d}
+}
@@ -0,0 +1,97 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/AlexanderGhosty/log-linter/pkg/logsupport"
+)
+
+func TestSpelling_Name(t *testing.T) {
+	r := NewSpelling(logsupport.NewRegistry(nil), nil)
+	if r.Name() != "spelling" {
+		t.Errorf("expected name 'spelling', got %q", r.Name())
+	}
+}
+
+func TestSpelling_Check(t *testing.T) {
+	r := NewSpelling(logsupport.NewRegistry(nil), []string{"kubernetes"})
+
+	tests := []struct {
+		name     string
+		msg      string
+		wantDiag string
+		wantFix  string
+	}{
+		{name: "correct", msg: "connection failed", wantDiag: ""},
+		{name: "user word", msg: "kubernetes pod started", wantDiag: ""},
+		{name: "identifiers and paths", msg: "loaded /etc/confg.yaml for userId", wantDiag: ""},
+		{
+			name:     "one misspelling",
+			msg:      "conection refused",
+			wantDiag: `log message contains misspelled word "conection" (did you mean "connection"?)`,
+			wantFix:  `"connection refused"`,
+		},
+		{
+			name:     "several misspellings",
+			msg:      "Recieved request, sending responce",
+			wantDiag: `log message contains misspelled words "Recieved" (did you mean "Received"?), "responce" (did you mean "response"?)`,
+			wantFix:  `"Received request, sending response"`,
+		},
+		{
+			name:     "ambiguous suggestion",
+			msg:      "field Nmae is empty",
+			wantDiag: `log message contains misspelled word "Nmae" (did you mean "Name"?)`,
+		},
+		{
+			name:     "two edits away",
+			msg:      "partitions rebalanced",
+			wantDiag: `log message contains misspelled word "rebalanced" (did you mean "imbalanced"?)`,
+		},
+		{
+			name:     "no suggestion",
+			msg:      "xqzvwk happened",
+			wantDiag: `log message contains misspelled word "xqzvwk"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := r.Check(Message{Text: tt.msg})
+			if tt.wantDiag == "" {
+				if len(diags) > 0 {
+					t.Errorf("Check(%q): unexpected diagnostic %q", tt.msg, diags[0].Message)
+				}
+				return
+			}
+			if len(diags) != 1 {
+				t.Fatalf("Check(%q): got %d diagnostics, want 1", tt.msg, len(diags))
+			}
+			if diags[0].Message != tt.wantDiag {
+				t.Errorf("Check(%q): message = %q, want %q", tt.msg, diags[0].Message, tt.wantDiag)
+			}
+			var gotFix string
+			if len(diags[0].SuggestedFixes) > 0 {
+				gotFix = string(diags[0].SuggestedFixes[0].TextEdits[0].NewText)
+			}
+			if gotFix != tt.wantFix {
+				t.Errorf("Check(%q): fix = %q, want %q", tt.msg, gotFix, tt.wantFix)
+			}
+		})
+	}
+}
+
+func TestSpelling_CheckFormat(t *testing.T) {
+	r := NewSpelling(logsupport.NewRegistry(nil), nil).(FormatRule)
+
+	if diags := r.CheckFormat(Message{Text: "retrying after %dms: %v"}); len(diags) > 0 {
+		t.Errorf("CheckFormat: unexpected diagnostic %q", diags[0].Message)
+	}
+
+	diags := r.CheckFormat(Message{Text: "user %s not fuond"})
+	if len(diags) != 1 {
+		t.Fatalf("CheckFormat: got %d diagnostics, want 1", len(diags))
+	}
+	if got := string(diags[0].SuggestedFixes[0].TextEdits[0].NewText); got != `"user %s not found"` {
+		t.Errorf("CheckFormat: fix = %q, want %q", got, `"user %s not found"`)
+	}
+}
@@ -4,9 +4,8 @@ import (
 	"fmt"
 	"go/ast"
 	"go/constant"
-	"go/token"
-	"strconv"
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/AlexanderGhosty/log-linter/pkg/logsupport"
 	"github.com/AlexanderGhosty/log-linter/pkg/utils"
@@ -37,36 +36,56 @@ func (r *Symbols) Name() string {
 }
 
 // Check validates a single log message string.
-func (r *Symbols) Check(msg string, pos, end token.Pos) []analysis.Diagnostic {
-	var cleanMsg []rune
-	hasBadChars := false
+func (r *Symbols) Check(msg Message) []analysis.Diagnostic {
+	return r.check(msg, nil)
+}
+
+// CheckFormat validates a printf-style format string.
+// Characters that belong to a verb (e.g. "%+v", "%[1]d") are always allowed.
+func (r *Symbols) CheckFormat(format Message) []analysis.Diagnostic {
+	verbs := utils.ParseFormat(format.Text)
+
+	return r.check(format, func(offset int) bool {
+		for _, v := range verbs {
+			if offset >= v.Start && offset < v.End {
+				return true
+			}
+		}
+		return false
+	})
+}
 
-	for _, ch := range msg {
-		if r.isAllowed(ch) {
-			cleanMsg = append(cleanMsg, ch)
+// check reports disallowed characters in msg. If inVerb is non-nil, characters
+// at byte offsets for which it returns true are kept as is.
+func (r *Symbols) check(msg Message, inVerb func(offset int) bool) []analysis.Diagnostic {
+	// Runs of disallowed characters, removed by the fix.
+	var repls []Replacement
+	for i, ch := range msg.Text {
+		if r.isAllowed(ch) || (inVerb != nil && inVerb(i)) {
+			continue
+		}
+		end := i + utf8.RuneLen(ch)
+		if n := len(repls); n > 0 && repls[n-1].End == i {
+			repls[n-1].End = end
 		} else {
-			hasBadChars = true
+			repls = append(repls, Replacement{Start: i, End: end})
 		}
 	}
 
-	if !hasBadChars {
+	if len(repls) == 0 {
 		return nil
 	}
 
-	// Suggest replacement
-	newMsg := string(cleanMsg)
+	pos, _ := msg.Range(repls[0].Start, repls[0].End)
+	_, end := msg.Range(repls[len(repls)-1].Start, repls[len(repls)-1].End)
 
 	return []analysis.Diagnostic{{
 		Pos:     pos,
 		End:     end,
 		Message: "log message should not contain special characters or emoji",
 		SuggestedFixes: []analysis.SuggestedFix{{
-			Message: fmt.Sprintf("remove special characters -> %q", newMsg),
-			TextEdits: []analysis.TextEdit{{
-				Pos:     pos,
-				End:     end,
-				NewText: []byte(strconv.Quote(newMsg)),
-			}},
+			Message:   fmt.Sprintf("remove special characters -> %q", msg.Apply(repls...)),
+			TextEdits: msg.Edits(repls...),
 		}},
 	}}
 }
@@ -76,10 +95,10 @@ func (r *Symbols) CheckCall(call *ast.CallExpr, pass *analysis.Pass) []analysis.
 	var diags []analysis.Diagnostic
 
 	// Determine message index
-	pkgPath, funcName, ok := utils.ResolveCallPackagePath(pass, call)
+	lc, ok := r.registry.Resolve(pass, call)
 	msgIndex := -1
 	if ok {
-		msgIndex = r.registry.MessageIndex(pkgPath, funcName)
+		msgIndex = lc.MessageIndex
 	}
 
 	r.registry.InspectLogArgs(pass, call, msgIndex, func(arg ast.Expr, isKey bool) {
@@ -87,7 +106,7 @@ func (r *Symbols) CheckCall(call *ast.CallExpr, pass *analysis.Pass) []analysis.
 			tv, ok := pass.TypesInfo.Types[arg]
 			if ok && tv.Value != nil && tv.Value.Kind() == constant.String {
 				val := constant.StringVal(tv.Value)
-				if d := r.Check(val, arg.Pos(), arg.End()); len(d) > 0 {
+				if d := r.Check(NewMessage(val, arg)); len(d) > 0 {
 					diags = append(diags, d...)
 				}
 			}
@@ -1,7 +1,6 @@
 package rules
 
 import (
-	"go/token"
 	"testing"
 
 	"github.com/AlexanderGhosty/log-linter/pkg/logsupport"
@@ -49,7 +48,7 @@ func TestSymbols_Check(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			diags := r.Check(tt.msg, token.NoPos, token.NoPos)
+			diags := r.Check(Message{Text: tt.msg})
 			gotDiag := len(diags) > 0
 			if gotDiag != tt.wantDiag {
 				t.Errorf("Check(%q): got diagnostic=%v, want diagnostic=%v", tt.msg, gotDiag, tt.wantDiag)
@@ -60,7 +59,7 @@ func TestSymbols_Check(t *testing.T) {
 
 func TestSymbols_SuggestedFix(t *testing.T) {
 	r := NewSymbols(logsupport.NewRegistry(nil), "")
-	diags := r.Check("hello!🚀", token.NoPos, token.NoPos)
+	diags := r.Check(Message{Text: "hello!🚀"})
 
 	if len(diags) == 0 {
 		t.Fatal("expected diagnostic")
@@ -76,3 +75,35 @@ func TestSymbols_SuggestedFix(t *testing.T) {
 		t.Errorf("expected fix %q, got %q", `"hello"`, newText)
 	}
 }
+
+func TestSymbols_CheckFormat(t *testing.T) {
+	r := NewSymbols(logsupport.NewRegistry(nil), "").(*Symbols)
+
+	tests := []struct {
+		name     string
+		format   string
+		wantFix  string
+		wantDiag bool
+	}{
+		{name: "plain verb", format: "user %s", wantDiag: false},
+		{name: "plus flag", format: "config %+v", wantDiag: false},
+		{name: "sharp flag", format: "config %#v", wantDiag: false},
+		{name: "explicit index", format: "user %[1]s", wantDiag: false},
+		{name: "bad char outside verb", format: "config %+v!", wantDiag: true, wantFix: `"config %+v"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := r.CheckFormat(Message{Text: tt.format})
+			gotDiag := len(diags) > 0
+			if gotDiag != tt.wantDiag {
+				t.Fatalf("CheckFormat(%q): got diagnostic=%v, want diagnostic=%v", tt.format, gotDiag, tt.wantDiag)
+			}
+			if gotDiag {
+				if got := string(diags[0].SuggestedFixes[0].TextEdits[0].NewText); got != tt.wantFix {
+					t.Errorf("CheckFormat(%q): fix = %s, want %s", tt.format, got, tt.wantFix)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/AlexanderGhosty/log-linter/pkg/logsupport"
+	"github.com/AlexanderGhosty/log-linter/pkg/taint"
+	"golang.org/x/tools/go/analysis"
+)
+
+// Taint checks for logged values derived from sensitive data, as tracked by
+// the taint analyzer (see package taint), e.g.
+//
+//	pw := req.FormValue("password")
+//	slog.Info("login", "value", pw)
+//
+// Values that the Sensitive rule already reports by their name are skipped.
+type Taint struct {
+	registry *logsupport.Registry
+	analyzer *analysis.Analyzer
+	names    *Sensitive
+	redact   redaction
+}
+
+// NewTaint creates a new Taint rule reading the results of the given taint
+// analyzer, which must be among the requirements of the running analyzer.
+// Tainted string attribute values are redacted as by the Sensitive rule.
+func NewTaint(registry *logsupport.Registry, analyzer *analysis.Analyzer, keywords []string, patterns []string, redact string) Rule {
+	if registry == nil {
+		registry = logsupport.NewRegistry(nil)
+	}
+
+	return &Taint{
+		registry: registry,
+		analyzer: analyzer,
+		names:    NewSensitive(registry, keywords, patterns, nil, "").(*Sensitive),
+		redact:   newRedaction(redact),
+	}
+}
+
+// Name returns the name of the rule.
+func (r *Taint) Name() string {
+	return "taint"
+}
+
+// Check does nothing: constant messages cannot be derived from sensitive data.
+func (r *Taint) Check(Message) []analysis.Diagnostic {
+	return nil
+}
+
+// CheckCall analyzes the message and the logged values of a log call.
+func (r *Taint) CheckCall(call *ast.CallExpr, pass *analysis.Pass) []analysis.Diagnostic {
+	res, _ := pass.ResultOf[r.analyzer].(*taint.Result)
+	if res == nil {
+		return nil
+	}
+	lc, ok := r.registry.Resolve(pass, call)
+	if !ok {
+		return nil
+	}
+	if err := res.Err(); err != nil {
+		return []analysis.Diagnostic{unchecked(pass, call, "sensitive data flow", err)}
+	}
+
+	var diags []analysis.Diagnostic
+	check := func(expr ast.Expr, what string) *analysis.Diagnostic {
+		source := res.Source(expr)
+		if source == "" || r.namesSensitive(expr) {
+			return nil
+		}
+		diags = append(diags, analysis.Diagnostic{
+			Pos:     expr.Pos(),
+			End:     expr.End(),
+			Message: fmt.Sprintf("%s is derived from sensitive data (%s)", what, source),
+		})
+		return &diags[len(diags)-1]
+	}
+
+	msgIndex := lc.MessageIndex
+	if msgIndex >= 0 && msgIndex < len(call.Args) {
+		check(call.Args[msgIndex], "log message")
+	}
+
+	if lc.Printf {
+		for _, arg := range printedOperands(pass, call, msgIndex) {
+			check(arg, "log format argument")
+		}
+	}
+
+	if lc.Print && msgIndex >= 0 {
+		for i := msgIndex + 1; i < len(call.Args); i++ {
+			check(call.Args[i], "log message")
+		}
+	}
+
+	r.registry.InspectLogArgs(pass, call, msgIndex, func(arg ast.Expr, isKey bool) {
+		if isKey {
+			return
+		}
+		if d := check(arg, "log attribute"); d != nil {
+			if fix := r.redact.fix(pass, arg); fix != nil {
+				d.SuggestedFixes = append(d.SuggestedFixes, *fix)
+			}
+		}
+	})
+
+	return diags
+}
+
+// namesSensitive reports whether the Sensitive rule reports expr by the
+// names and literals it is made of.
+func (r *Taint) namesSensitive(expr ast.Expr) bool {
+	found := false
+	checkOperand(expr, r.names, func(token.Pos, token.Pos, string) {
+		found = true
+	}, "")
+	return found
+}
+
+// unchecked reports that what could not be checked in the file of call, as
+// the analysis it needs failed with err. The diagnostic is on the package
+// clause of the file, so that it is reported once per file.
+func unchecked(pass *analysis.Pass, call *ast.CallExpr, what string, err error) analysis.Diagnostic {
+	pos := call.Pos()
+	for _, f := range pass.Files {
+		if f.FileStart <= pos && pos <= f.FileEnd {
+			pos = f.Package
+			break
+		}
+	}
+	return analysis.Diagnostic{
+		Pos:     pos,
+		End:     pos + token.Pos(len("package")),
+		Message: fmt.Sprintf("%s is not checked: %v", what, err),
+	}
+}
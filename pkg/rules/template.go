@@ -0,0 +1,313 @@
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"github.com/AlexanderGhosty/log-linter/pkg/logsupport"
+	"github.com/AlexanderGhosty/log-linter/pkg/utils"
+	"golang.org/x/tools/go/analysis"
+)
+
+// Template checks that structured log calls (slog, zap, zerolog, logrus and
+// generic loggers taking key-value pairs) use a constant message, so that
+// events can be grouped by message: messages built from variable data with
+// concatenation, fmt.Sprintf, fmt.Sprint, err.Error() or a string conversion
+// are reported. For key-value calls the fix moves the variable parts into
+// attributes, e.g. slog.Info("user " + id) becomes slog.Info("user", "id", id).
+type Template struct {
+	registry *logsupport.Registry
+	// keyStyle is the naming convention of the keys added by the fix.
+	keyStyle utils.KeyStyle
+}
+
+// NewTemplate creates a new Template rule. The keys of the attributes added
+// by its fix, named after the logged variables and fields, follow the key
+// naming convention keyStyle (see utils.LookupKeyStyle; snake_case if empty
+// or unknown, e.g. "regex").
+func NewTemplate(registry *logsupport.Registry, keyStyle string) Rule {
+	if registry == nil {
+		registry = logsupport.NewRegistry(nil)
+	}
+
+	style, ok := utils.LookupKeyStyle(keyStyle)
+	if !ok {
+		style, _ = utils.LookupKeyStyle("snake_case")
+	}
+	return &Template{registry: registry, keyStyle: style}
+}
+
+// Name returns the name of the rule.
+func (r *Template) Name() string {
+	return "message-template"
+}
+
+// Check does nothing: constant messages follow the convention.
+func (r *Template) Check(Message) []analysis.Diagnostic {
+	return nil
+}
+
+// templatePart is a part of a message built at run time: constant text, or
+// a value to log as an attribute.
+type templatePart struct {
+	value ast.Expr
+	text  string
+}
+
+// CheckCall analyzes the message of a structured log call.
+func (r *Template) CheckCall(call *ast.CallExpr, pass *analysis.Pass) []analysis.Diagnostic {
+	lc, ok := r.registry.Resolve(pass, call)
+	if !ok || !structured(lc) || lc.Printf || lc.MessageIndex < 0 || lc.MessageIndex >= len(call.Args) {
+		return nil
+	}
+	// Print-style calls (e.g. logrus.Info) join all their arguments.
+	if lc.Print && len(call.Args) != lc.MessageIndex+1 {
+		return nil
+	}
+
+	arg := call.Args[lc.MessageIndex]
+	if tv, ok := pass.TypesInfo.Types[arg]; !ok || tv.Value != nil || !builtMessage(pass, arg) {
+		return nil
+	}
+
+	d := analysis.Diagnostic{
+		Pos:     arg.Pos(),
+		End:     arg.End(),
+		Message: "log message is built from variable data; use a constant message and log the data as attributes",
+	}
+	if fix := r.fix(pass, lc, call); fix != nil {
+		d.SuggestedFixes = []analysis.SuggestedFix{*fix}
+	}
+	return []analysis.Diagnostic{d}
+}
+
+// structured reports whether the log call's logger records attributes
+// separately from the message.
+func structured(lc logsupport.Call) bool {
+	switch lc.UserType {
+	case "slog", "zap", "zerolog", "logrus":
+		return true
+	}
+	return lc.KeyValues
+}
+
+// builtMessage reports whether expr builds a string from other values:
+// a concatenation, a call to fmt.Sprintf, fmt.Sprint or fmt.Sprintln, an
+// Error or String method call, or a conversion to string.
+func builtMessage(pass *analysis.Pass, expr ast.Expr) bool {
+	switch expr := ast.Unparen(expr).(type) {
+	case *ast.BinaryExpr:
+		return expr.Op == token.ADD
+	case *ast.CallExpr:
+		if tv, ok := pass.TypesInfo.Types[expr.Fun]; ok && tv.IsType() {
+			return true
+		}
+		switch logsupport.MessageFunc(pass, expr) {
+		case "fmt.Sprintf", "fmt.Sprint", "fmt.Sprintln":
+			return true
+		}
+		_, ok := stringMethodReceiver(pass, expr)
+		return ok
+	}
+	return false
+}
+
+// fix returns the fix that replaces the message of a key-value call with its
+// constant text and logs its variable parts as attributes, or nil if the
+// parts cannot be split or named.
+func (r *Template) fix(pass *analysis.Pass, lc logsupport.Call, call *ast.CallExpr) *analysis.SuggestedFix {
+	// The new attributes are inserted right after the message.
+	if !lc.KeyValues || lc.Func == "LogAttrs" || lc.ArgsIndex != lc.MessageIndex+1 || call.Ellipsis.IsValid() {
+		return nil
+	}
+	arg := call.Args[lc.MessageIndex]
+	parts, ok := templateParts(pass, arg)
+	if !ok {
+		return nil
+	}
+
+	keys := make(map[string]bool)
+	r.registry.InspectLogArgs(pass, call, lc.MessageIndex, func(arg ast.Expr, isKey bool) {
+		if tv, ok := pass.TypesInfo.Types[arg]; isKey && ok && tv.Value != nil && tv.Value.Kind() == constant.String {
+			keys[constant.StringVal(tv.Value)] = true
+		}
+	})
+
+	var (
+		edits []analysis.TextEdit
+		attrs []string
+	)
+	// Each value is kept in place: the source between the values is replaced
+	// with the new message and keys.
+	last := arg.Pos()
+	for _, p := range parts {
+		if p.value == nil {
+			continue
+		}
+		key, value, ok := attribute(pass, p.value)
+		if !ok {
+			return nil
+		}
+		if converted := r.keyStyle.Convert(key); converted != "" {
+			key = converted
+		}
+		if keys[key] {
+			return nil
+		}
+		keys[key] = true
+		attrs = append(attrs, key)
+		edits = append(edits, analysis.TextEdit{Pos: last, End: value.Pos()})
+		last = value.End()
+	}
+	msg := templateText(parts)
+	if msg == "" || len(attrs) == 0 {
+		return nil
+	}
+	if last != arg.End() {
+		edits = append(edits, analysis.TextEdit{Pos: last, End: arg.End()})
+	}
+
+	for i := range edits[:len(attrs)] {
+		prefix := ", "
+		if i == 0 {
+			prefix = strconv.Quote(msg) + ", "
+		}
+		edits[i].NewText = []byte(prefix + strconv.Quote(attrs[i]) + ", ")
+	}
+
+	return &analysis.SuggestedFix{
+		Message:   fmt.Sprintf("use message %q with attributes %s", msg, strings.Join(attrs, ", ")),
+		TextEdits: edits,
+	}
+}
+
+// templateParts splits a message built at run time into its constant text
+// and values, in source order. It fails if the message is not built from
+// parts that can be logged separately, e.g. a format with '*' widths.
+func templateParts(pass *analysis.Pass, expr ast.Expr) ([]templatePart, bool) {
+	expr = ast.Unparen(expr)
+	if tv, ok := pass.TypesInfo.Types[expr]; ok && tv.Value != nil {
+		if tv.Value.Kind() != constant.String {
+			return nil, false
+		}
+		return []templatePart{{text: constant.StringVal(tv.Value)}}, true
+	}
+
+	switch expr := expr.(type) {
+	case *ast.BinaryExpr:
+		if expr.Op != token.ADD {
+			break
+		}
+		x, ok := templateParts(pass, expr.X)
+		if !ok {
+			return nil, false
+		}
+		y, ok := templateParts(pass, expr.Y)
+		return append(x, y...), ok
+	case *ast.CallExpr:
+		switch logsupport.MessageFunc(pass, expr) {
+		case "fmt.Sprintf":
+			return formatParts(pass, expr)
+		case "fmt.Sprint", "fmt.Sprintln":
+			var parts []templatePart
+			for _, a := range expr.Args {
+				p, ok := templateParts(pass, a)
+				if !ok {
+					return nil, false
+				}
+				parts = append(parts, p...)
+			}
+			return parts, true
+		}
+	}
+	return []templatePart{{value: expr}}, true
+}
+
+// templateText returns the constant text of a message built at run time,
+// without the separators around its values (e.g. "user" for "user=" + id).
+func templateText(parts []templatePart) string {
+	var words []string
+	for _, p := range parts {
+		if text := strings.Trim(p.text, " \t\n:=,;"); p.value == nil && text != "" {
+			words = append(words, text)
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// formatParts splits a fmt.Sprintf call with a constant format whose verbs
+// consume the operands in order.
+func formatParts(pass *analysis.Pass, call *ast.CallExpr) ([]templatePart, bool) {
+	tv, ok := pass.TypesInfo.Types[call.Args[0]]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String || call.Ellipsis.IsValid() {
+		return nil, false
+	}
+	format := constant.StringVal(tv.Value)
+	operands := call.Args[1:]
+
+	var parts []templatePart
+	last, next := 0, 0
+	for _, v := range utils.ParseFormat(format) {
+		text := format[last:v.Start]
+		last = v.End
+		if v.ArgIndex < 0 {
+			parts = append(parts, templatePart{text: text + "%"})
+			continue
+		}
+		if len(v.StarArgs) > 0 || v.ArgIndex != next || next >= len(operands) || !v.PrintsOperand() {
+			return nil, false
+		}
+		parts = append(parts, templatePart{text: text}, templatePart{value: operands[next]})
+		next++
+	}
+	if next != len(operands) {
+		return nil, false
+	}
+	return append(parts, templatePart{text: format[last:]}), true
+}
+
+// attribute returns the key and the value to log for a variable part of a
+// message: err for err.Error() and user.ID for user.ID or string(user.ID),
+// with the name of the variable or field as key.
+func attribute(pass *analysis.Pass, expr ast.Expr) (string, ast.Expr, bool) {
+	expr = ast.Unparen(expr)
+	value := expr
+	if call, ok := expr.(*ast.CallExpr); ok {
+		if tv, ok := pass.TypesInfo.Types[call.Fun]; ok && tv.IsType() && len(call.Args) == 1 {
+			return attribute(pass, call.Args[0])
+		}
+		recv, ok := stringMethodReceiver(pass, call)
+		if !ok {
+			return "", nil, false
+		}
+		if sel := call.Fun.(*ast.SelectorExpr); sel.Sel.Name == "Error" {
+			value = recv
+		}
+		expr = ast.Unparen(recv)
+	}
+
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		return expr.Name, value, true
+	case *ast.SelectorExpr:
+		return expr.Sel.Name, value, true
+	}
+	return "", nil, false
+}
+
+// stringMethodReceiver returns x for a call x.Error() or x.String().
+func stringMethodReceiver(pass *analysis.Pass, call *ast.CallExpr) (ast.Expr, bool) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || len(call.Args) != 0 || (sel.Sel.Name != "Error" && sel.Sel.Name != "String") {
+		return nil, false
+	}
+	if _, ok := pass.TypesInfo.ObjectOf(sel.Sel).(*types.Func); !ok {
+		return nil, false
+	}
+	return sel.X, true
+}
@@ -0,0 +1,37 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/AlexanderGhosty/log-linter/pkg/logsupport"
+)
+
+func TestTemplate_Name(t *testing.T) {
+	r := NewTemplate(logsupport.NewRegistry(nil), "")
+	if r.Name() != "message-template" {
+		t.Errorf("expected name 'message-template', got %q", r.Name())
+	}
+	if diags := r.Check(Message{Text: "user logged in"}); len(diags) > 0 {
+		t.Errorf("Check reported constant messages: %v", diags)
+	}
+}
+
+func TestNewTemplate_KeyStyle(t *testing.T) {
+	tests := []struct {
+		style string
+		want  string
+	}{
+		{style: "", want: "user_id"},
+		{style: "camelCase", want: "userId"},
+		{style: "regex", want: "user_id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.style, func(t *testing.T) {
+			r := NewTemplate(nil, tt.style).(*Template)
+			if got := r.keyStyle.Convert("UserID"); got != tt.want {
+				t.Errorf("key for %q = %q, want %q", "UserID", got, tt.want)
+			}
+		})
+	}
+}
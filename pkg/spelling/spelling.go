@@ -0,0 +1,289 @@
+// Package spelling implements dictionary-based spell checking of log
+// messages and identifiers.
+package spelling
+
+import (
+	"bufio"
+	_ "embed"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// minWordLength is the length below which words are not checked; short words
+// are mostly abbreviations (e.g. "id", "ok", "db").
+const minWordLength = 3
+
+//go:embed words.txt
+var englishWords string
+
+// englishRanks returns the rank of each word of the embedded English word
+// list. The list is parsed once and shared by all dictionaries.
+var englishRanks = sync.OnceValue(func() map[string]int {
+	words, _ := ParseWords(strings.NewReader(englishWords))
+	ranks := make(map[string]int, len(words))
+	addRanks(ranks, 0, words)
+	return ranks
+})
+
+// Dictionary is a set of correctly spelled words.
+type Dictionary struct {
+	// english and words hold the position of each word in the English word
+	// list and in the user words, used to prefer frequent words among
+	// suggestions. User words rank last.
+	english map[string]int
+	words   map[string]int
+}
+
+// New returns a dictionary of the embedded English word list and the given
+// words.
+func New(words []string) *Dictionary {
+	d := &Dictionary{english: englishRanks(), words: make(map[string]int, len(words))}
+	addRanks(d.words, len(d.english), words)
+	return d
+}
+
+// addRanks adds the lowercased words not yet in ranks, ranked from base on.
+func addRanks(ranks map[string]int, base int, words []string) {
+	for _, w := range words {
+		w = strings.ToLower(w)
+		if _, ok := ranks[w]; !ok {
+			ranks[w] = base + len(ranks)
+		}
+	}
+}
+
+// ParseWords reads a word list with one word per line. Blank lines and lines
+// starting with '#' are ignored.
+func ParseWords(r io.Reader) ([]string, error) {
+	var words []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		words = append(words, line)
+	}
+	return words, scanner.Err()
+}
+
+// Contains reports whether word, in any case, is spelled correctly.
+func (d *Dictionary) Contains(word string) bool {
+	_, ok := d.rank(strings.ToLower(word))
+	return ok
+}
+
+// rank returns the rank of the lowercase word, if it is in the dictionary.
+func (d *Dictionary) rank(word string) (int, bool) {
+	if r, ok := d.english[word]; ok {
+		return r, true
+	}
+	r, ok := d.words[word]
+	return r, ok
+}
+
+// Suggest returns the dictionary words closest to word, best first and in
+// the case of word, and their distance from it: the words within one edit
+// (an insertion, deletion, substitution or transposition of letters) of
+// word or, if there are none, within two edits for words of at least five
+// letters. Words that word only adds a prefix or suffix to (e.g.
+// "connecting" for "reconnecting") are not suggested: they are more likely
+// a different word than a typo.
+//
+// Longer words come first, since dropped letters are the most common typos,
+// and then frequent words.
+func (d *Dictionary) Suggest(word string) (suggestions []string, distance int) {
+	lower := strings.ToLower(word)
+	edits := edits1(lower)
+	found := d.known(lower, edits)
+	distance = 1
+	if len(found) == 0 && utf8.RuneCountInString(lower) >= 5 {
+		var edits2 []string
+		for _, e := range edits {
+			edits2 = append(edits2, edits1(e)...)
+		}
+		found, distance = d.known(lower, edits2), 2
+	}
+	if len(found) == 0 {
+		return nil, 0
+	}
+
+	slices.SortFunc(found, func(a, b string) int {
+		if lenA, lenB := lengthOrder(lower, a), lengthOrder(lower, b); lenA != lenB {
+			return lenA - lenB
+		}
+		rankA, _ := d.rank(a)
+		rankB, _ := d.rank(b)
+		return rankA - rankB
+	})
+	for i, s := range found {
+		found[i] = matchCase(s, word)
+	}
+	return found, distance
+}
+
+// known returns the distinct candidates in the dictionary, except word and
+// the words it adds a prefix or suffix to.
+func (d *Dictionary) known(word string, candidates []string) []string {
+	var found []string
+	seen := make(map[string]bool)
+	for _, c := range candidates {
+		if seen[c] || c == word || strings.HasPrefix(word, c) || strings.HasSuffix(word, c) {
+			continue
+		}
+		seen[c] = true
+		if _, ok := d.rank(c); ok {
+			found = append(found, c)
+		}
+	}
+	return found
+}
+
+// lengthOrder orders suggestions for word: longer words (0) before words of
+// the same length (1) before shorter words (2).
+func lengthOrder(word, suggestion string) int {
+	switch {
+	case len(suggestion) > len(word):
+		return 0
+	case len(suggestion) == len(word):
+		return 1
+	}
+	return 2
+}
+
+// edits1 returns the strings within one edit of word, using lowercase ASCII
+// letters for insertions and substitutions.
+func edits1(word string) []string {
+	const letters = "abcdefghijklmnopqrstuvwxyz"
+	runes := []rune(word)
+	var edits []string
+	for i := 0; i <= len(runes); i++ {
+		prefix, suffix := string(runes[:i]), runes[i:]
+		if len(suffix) > 0 {
+			edits = append(edits, prefix+string(suffix[1:]))
+		}
+		if len(suffix) > 1 {
+			edits = append(edits, prefix+string(suffix[1])+string(suffix[0])+string(suffix[2:]))
+		}
+		for _, c := range letters {
+			if len(suffix) > 0 && suffix[0] != c {
+				edits = append(edits, prefix+string(c)+string(suffix[1:]))
+			}
+			edits = append(edits, prefix+string(c)+string(suffix))
+		}
+	}
+	return edits
+}
+
+// matchCase returns word in the case of model: all uppercase, capitalized or
+// lowercase.
+func matchCase(word, model string) string {
+	first, _ := utf8.DecodeRuneInString(model)
+	switch {
+	case strings.ToUpper(model) == model && utf8.RuneCountInString(model) > 1:
+		return strings.ToUpper(word)
+	case unicode.IsUpper(first):
+		r, size := utf8.DecodeRuneInString(word)
+		return string(unicode.ToUpper(r)) + word[size:]
+	}
+	return word
+}
+
+// Span is the byte range [Start, End) of a word within a string.
+type Span struct {
+	Start, End int
+}
+
+// Words returns the words of s to be spell checked. Identifiers are split at
+// underscores, hyphens and camelCase boundaries (e.g. "userName" and
+// "user_name" both yield "user" and "name"). Tokens that contain digits or
+// look like paths, URLs, hosts or key=value pairs are skipped, as are
+// acronyms (e.g. "HTTP") and words shorter than three letters.
+func Words(s string) []Span {
+	var spans []Span
+	for _, field := range fields(s) {
+		token := s[field.Start:field.End]
+		if strings.ContainsAny(token, "0123456789/\\.@:=%<>{}[]()") {
+			continue
+		}
+		spans = append(spans, splitIdentifier(token, field.Start)...)
+	}
+	return spans
+}
+
+// fields returns the whitespace-separated fields of s, without leading and
+// trailing punctuation.
+func fields(s string) []Span {
+	var spans []Span
+	start := -1
+	flush := func(end int) {
+		if start < 0 {
+			return
+		}
+		token := s[start:end]
+		trimmed := strings.TrimLeftFunc(token, isPunct)
+		lead := len(token) - len(trimmed)
+		trimmed = strings.TrimRightFunc(trimmed, isPunct)
+		if trimmed != "" {
+			spans = append(spans, Span{Start: start + lead, End: start + lead + len(trimmed)})
+		}
+		start = -1
+	}
+	for i, r := range s {
+		if unicode.IsSpace(r) {
+			flush(i)
+		} else if start < 0 {
+			start = i
+		}
+	}
+	flush(len(s))
+	return spans
+}
+
+func isPunct(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+// splitIdentifier splits token, found at offset in its string, into words.
+func splitIdentifier(token string, offset int) []Span {
+	var spans []Span
+	add := func(start, end int) {
+		word := token[start:end]
+		if utf8.RuneCountInString(word) < minWordLength {
+			return
+		}
+		if strings.ToUpper(word) == word {
+			return // acronym
+		}
+		spans = append(spans, Span{Start: offset + start, End: offset + end})
+	}
+
+	start := 0
+	var prev rune
+	for i, r := range token {
+		switch {
+		case !unicode.IsLetter(r):
+			// Separators such as '_', '-' and apostrophes.
+			add(start, i)
+			start = i + utf8.RuneLen(r)
+		case i > start && unicode.IsUpper(r) && unicode.IsLower(prev):
+			// "userName": split before "N".
+			add(start, i)
+			start = i
+		case i > start && unicode.IsLower(r) && unicode.IsUpper(prev):
+			// "HTTPServer": split before "S" unless the word began there.
+			prevStart := i - utf8.RuneLen(prev)
+			if prevStart > start {
+				add(start, prevStart)
+				start = prevStart
+			}
+		}
+		prev = r
+	}
+	add(start, len(token))
+	return spans
+}
@@ -0,0 +1,94 @@
+package spelling
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDictionary_Contains(t *testing.T) {
+	d := New([]string{"Kubernetes"})
+
+	tests := []struct {
+		word string
+		want bool
+	}{
+		{word: "connection", want: true},
+		{word: "Failed", want: true},
+		{word: "kubernetes", want: true},
+		{word: "conection", want: false},
+		{word: "faild", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := d.Contains(tt.word); got != tt.want {
+			t.Errorf("Contains(%q) = %v, want %v", tt.word, got, tt.want)
+		}
+	}
+}
+
+func TestDictionary_Suggest(t *testing.T) {
+	d := New(nil)
+
+	tests := []struct {
+		word     string
+		want     []string
+		distance int
+	}{
+		{word: "conection", want: []string{"connection"}, distance: 1},
+		{word: "recieved", want: []string{"received"}, distance: 1},
+		{word: "Sucessfully", want: []string{"Successfully"}, distance: 1},
+		{word: "TIMOUT", want: []string{"TIMEOUT"}, distance: 1},
+		{word: "Nmae", want: []string{"Name", "Nmax"}, distance: 1},
+		{word: "rebalanced", want: []string{"imbalanced", "unbalanced"}, distance: 2},
+		{word: "reconnecting", want: nil},
+		{word: "xqzvwk", want: nil},
+	}
+
+	for _, tt := range tests {
+		got, distance := d.Suggest(tt.word)
+		if !reflect.DeepEqual(got, tt.want) || distance != tt.distance {
+			t.Errorf("Suggest(%q) = %q, %d, want %q, %d", tt.word, got, distance, tt.want, tt.distance)
+		}
+	}
+}
+
+func TestWords(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []string
+	}{
+		{input: "connection failed", want: []string{"connection", "failed"}},
+		{input: "userName", want: []string{"user", "Name"}},
+		{input: "user_name", want: []string{"user", "name"}},
+		{input: "HTTPServer started", want: []string{"Server", "started"}},
+		{input: "(retrying) after: error!", want: []string{"retrying", "after", "error"}},
+		{input: "id ok db", want: nil},
+		{input: "loaded /etc/app.yaml from example.com", want: []string{"loaded", "from"}},
+		{input: "key=value user@host v2", want: nil},
+		{input: "don't retry", want: []string{"don", "retry"}},
+		{input: "read-only café", want: []string{"read", "only", "café"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			var got []string
+			for _, span := range Words(tt.input) {
+				got = append(got, tt.input[span.Start:span.End])
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Words(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseWords(t *testing.T) {
+	words, err := ParseWords(strings.NewReader("# team words\nkubernetes\n\n  grpc  \n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"kubernetes", "grpc"}; !reflect.DeepEqual(words, want) {
+		t.Errorf("ParseWords() = %q, want %q", words, want)
+	}
+}
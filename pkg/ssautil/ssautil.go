@@ -0,0 +1,101 @@
+// Package ssautil defines an analyzer that builds the SSA form of each
+// package, for the analyzers that follow values through its functions, and
+// holds the helpers they and the rules share.
+package ssautil
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"reflect"
+	"sync"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ssa"
+)
+
+// SSA is the result of Analyzer. The SSA form is built on first use, so
+// that packages whose analyzers do not need it, such as packages without log
+// calls, do not pay for it.
+type SSA struct {
+	build func() ([]*ssa.Function, error)
+}
+
+// SrcFuncs returns the functions declared in the package, including function
+// literals and the package initializer.
+//
+// The SSA builder panics on syntax it does not support, which happens when
+// the package was type-checked by a newer toolchain; the panic is returned
+// as an error. It is not an error of the analysis, which also runs on every
+// dependency of the packages being checked: the analyzers using the SSA form
+// pass it on, to be reported where their results are used.
+func (s *SSA) SrcFuncs() ([]*ssa.Function, error) {
+	return s.build()
+}
+
+// Analyzer builds the SSA form of each package with debug information, which
+// is what maps syntax to SSA values (see ssa.DebugRef) and what
+// buildssa.Analyzer leaves out. The analyzers that require it share one
+// build per package.
+var Analyzer = &analysis.Analyzer{
+	Name: "loglinterssa",
+	Doc:  "builds the SSA form, with debug information, for the loglinter analyzers",
+	Run: func(pass *analysis.Pass) (any, error) {
+		return &SSA{build: sync.OnceValues(func() ([]*ssa.Function, error) {
+			return build(pass)
+		})}, nil
+	},
+	ResultType: reflect.TypeOf((*SSA)(nil)),
+}
+
+// build builds the SSA form of the package and returns its source functions.
+func build(pass *analysis.Pass) (funcs []*ssa.Function, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			funcs, err = nil, fmt.Errorf("building SSA form of %s: %v", pass.Pkg.Path(), r)
+		}
+	}()
+
+	// The packages of the types and functions used by the package must be
+	// created, including those it does not import directly.
+	prog := ssa.NewProgram(pass.Fset, ssa.GlobalDebug)
+	created := make(map[*types.Package]bool)
+	var create func(pkgs []*types.Package)
+	create = func(pkgs []*types.Package) {
+		for _, p := range pkgs {
+			if !created[p] {
+				created[p] = true
+				prog.CreatePackage(p, nil, nil, true)
+				create(p.Imports())
+			}
+		}
+	}
+	create(pass.Pkg.Imports())
+	ssapkg := prog.CreatePackage(pass.Pkg, pass.Files, pass.TypesInfo, false)
+	ssapkg.Build()
+
+	var add func(fn *ssa.Function)
+	add = func(fn *ssa.Function) {
+		funcs = append(funcs, fn)
+		for _, anon := range fn.AnonFuncs {
+			add(anon)
+		}
+	}
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			fdecl, ok := decl.(*ast.FuncDecl)
+			if !ok {
+				continue
+			}
+			if obj, ok := pass.TypesInfo.Defs[fdecl.Name].(*types.Func); ok {
+				if fn := prog.FuncValue(obj); fn != nil {
+					add(fn)
+				}
+			}
+		}
+	}
+	if init := ssapkg.Func("init"); init != nil {
+		add(init)
+	}
+	return funcs, nil
+}
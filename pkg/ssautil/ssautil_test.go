@@ -0,0 +1,74 @@
+package ssautil
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const src = `package p
+
+func F(s string) string {
+	return s + "!"
+}
+`
+
+// newPass type-checks src into a pass over package p.
+func newPass(t *testing.T) *analysis.Pass {
+	t.Helper()
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "p.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	info := &types.Info{
+		Types:      make(map[ast.Expr]types.TypeAndValue),
+		Defs:       make(map[*ast.Ident]types.Object),
+		Uses:       make(map[*ast.Ident]types.Object),
+		Implicits:  make(map[ast.Node]types.Object),
+		Instances:  make(map[*ast.Ident]types.Instance),
+		Scopes:     make(map[ast.Node]*types.Scope),
+		Selections: make(map[*ast.SelectorExpr]*types.Selection),
+	}
+	conf := types.Config{Importer: importer.Default()}
+	pkg, err := conf.Check("p", fset, []*ast.File{f}, info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &analysis.Pass{Fset: fset, Files: []*ast.File{f}, Pkg: pkg, TypesInfo: info}
+}
+
+func TestBuild(t *testing.T) {
+	funcs, err := build(newPass(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, fn := range funcs {
+		names = append(names, fn.Name())
+	}
+	if got := strings.Join(names, " "); got != "F init" {
+		t.Errorf("build() functions = %q, want %q", got, "F init")
+	}
+}
+
+// TestBuild_Panic checks that a panic of the SSA builder, here on an
+// expression without type information, is returned as an error.
+func TestBuild_Panic(t *testing.T) {
+	pass := newPass(t)
+	clear(pass.TypesInfo.Types)
+
+	funcs, err := build(pass)
+	if err == nil || !strings.HasPrefix(err.Error(), "building SSA form of p: ") {
+		t.Errorf("build() error = %v, want a build error", err)
+	}
+	if funcs != nil {
+		t.Errorf("build() functions = %v, want none", funcs)
+	}
+}
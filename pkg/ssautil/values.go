@@ -0,0 +1,34 @@
+package ssautil
+
+import (
+	"go/types"
+
+	"golang.org/x/tools/go/ssa"
+)
+
+// errorType is the predeclared error interface.
+var errorType = types.Universe.Lookup("error").Type().Underlying().(*types.Interface)
+
+// IsError reports whether t implements error. The type of nil does not.
+func IsError(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+	if basic, ok := t.(*types.Basic); ok && basic.Kind() == types.UntypedNil {
+		return false
+	}
+	return types.Implements(t, errorType)
+}
+
+// AllocOf returns the local variable, array or struct that addr points
+// into, if any.
+func AllocOf(addr ssa.Value) *ssa.Alloc {
+	switch a := addr.(type) {
+	case *ssa.IndexAddr:
+		addr = a.X
+	case *ssa.FieldAddr:
+		addr = a.X
+	}
+	alloc, _ := addr.(*ssa.Alloc)
+	return alloc
+}
@@ -0,0 +1,31 @@
+package ssautil_test
+
+import (
+	"go/types"
+	"testing"
+
+	"github.com/AlexanderGhosty/log-linter/pkg/ssautil"
+)
+
+func TestIsError(t *testing.T) {
+	errorT := types.Universe.Lookup("error").Type()
+	tests := []struct {
+		typ  types.Type
+		name string
+		want bool
+	}{
+		{name: "error", typ: errorT, want: true},
+		{name: "string", typ: types.Typ[types.String], want: false},
+		{name: "untyped nil", typ: types.Typ[types.UntypedNil], want: false},
+		{name: "any", typ: types.NewInterfaceType(nil, nil), want: false},
+		{name: "nil", typ: nil, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ssautil.IsError(tt.typ); got != tt.want {
+				t.Errorf("IsError(%v) = %v, want %v", tt.typ, got, tt.want)
+			}
+		})
+	}
+}
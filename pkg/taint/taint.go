@@ -0,0 +1,298 @@
+// Package taint defines an analyzer that tracks sensitive values through the
+// SSA form of each function, so that log calls can be checked for arguments
+// derived from them, e.g.
+//
+//	pw := req.FormValue("password")
+//	slog.Info("login", "value", pw)
+//
+// The analysis is intra-procedural: values are tainted by sources within the
+// same function and propagated through assignments, conversions, string
+// concatenation and string formatting, but not through calls to other
+// functions of the program.
+package taint
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/AlexanderGhosty/log-linter/pkg/ssautil"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ssa"
+)
+
+// DefaultSources are the functions whose result is sensitive if one of their
+// constant string arguments names a secret, e.g. os.Getenv("DB_PASSWORD").
+// Functions are identified by types.Func.FullName.
+var DefaultSources = []string{
+	"(*net/http.Request).FormValue",
+	"(*net/http.Request).PostFormValue",
+	"(net/http.Header).Get",
+	"(net/url.Values).Get",
+	"os.Getenv",
+	"os.LookupEnv",
+}
+
+// Result maps expressions of the analyzed package to the reason they are
+// sensitive. It is computed on first use, so that packages without log calls
+// do not pay for building their SSA form.
+type Result struct {
+	exprs func() (map[ast.Expr]string, error)
+}
+
+// Source returns a description of the sensitive source expr is derived from
+// (e.g. `FormValue("password")`), or "" if it is not tainted.
+func (r *Result) Source(expr ast.Expr) string {
+	if r == nil {
+		return ""
+	}
+	exprs, _ := r.exprs()
+	return exprs[ast.Unparen(expr)]
+}
+
+// Err returns the error the SSA form of the package failed to build with, if
+// any (see ssautil.SSA.SrcFuncs). No expression is then sensitive.
+func (r *Result) Err() error {
+	if r == nil {
+		return nil
+	}
+	_, err := r.exprs()
+	return err
+}
+
+// New returns a taint analyzer. Values are sensitive if they are read from a
+// field, or returned by a function or method, whose name contains one of the
+// keywords, or returned by one of the source functions called with a
+// constant string argument that contains one of the keywords. Keywords must
+// be lowercase.
+func New(keywords, sources []string) *analysis.Analyzer {
+	if sources == nil {
+		sources = DefaultSources
+	}
+	t := &tracker{keywords: keywords, sources: make(map[string]bool, len(sources))}
+	for _, s := range sources {
+		t.sources[s] = true
+	}
+
+	return &analysis.Analyzer{
+		Name:       "loglintertaint",
+		Doc:        "tracks sensitive values for the loglinter analyzer",
+		Run:        t.run,
+		Requires:   []*analysis.Analyzer{ssautil.Analyzer},
+		ResultType: reflect.TypeOf((*Result)(nil)),
+	}
+}
+
+type tracker struct {
+	sources  map[string]bool
+	keywords []string
+}
+
+func (t *tracker) run(pass *analysis.Pass) (any, error) {
+	ssaResult := pass.ResultOf[ssautil.Analyzer].(*ssautil.SSA)
+	return &Result{exprs: sync.OnceValues(func() (map[ast.Expr]string, error) {
+		funcs, err := ssaResult.SrcFuncs()
+		if err != nil {
+			return nil, err
+		}
+		exprs := make(map[ast.Expr]string)
+		for _, fn := range funcs {
+			t.analyze(fn, exprs)
+		}
+		return exprs, nil
+	})}, nil
+}
+
+// analyze computes the tainted values of fn and records the expressions
+// that evaluate to them.
+func (t *tracker) analyze(fn *ssa.Function, exprs map[ast.Expr]string) {
+	// Local variables whose address is taken, and the backing arrays of
+	// variadic arguments, live in memory (ssa.Alloc); they are tainted when a
+	// tainted value is stored into them or into one of their elements.
+	tainted := make(map[ssa.Value]string)
+
+	for changed := true; changed; {
+		changed = false
+		for _, b := range fn.Blocks {
+			for _, instr := range b.Instrs {
+				if store, ok := instr.(*ssa.Store); ok {
+					// A struct is not tainted by one of its fields: its other
+					// fields are read through it.
+					if _, field := store.Addr.(*ssa.FieldAddr); field {
+						continue
+					}
+					if alloc := ssautil.AllocOf(store.Addr); alloc != nil && tainted[alloc] == "" && tainted[store.Val] != "" {
+						tainted[alloc] = tainted[store.Val]
+						changed = true
+					}
+					continue
+				}
+				v, ok := instr.(ssa.Value)
+				if !ok || tainted[v] != "" {
+					continue
+				}
+				if reason := t.taint(v, tainted); reason != "" {
+					tainted[v] = reason
+					changed = true
+				}
+			}
+		}
+	}
+
+	for _, b := range fn.Blocks {
+		for _, instr := range b.Instrs {
+			if ref, ok := instr.(*ssa.DebugRef); ok && !ref.IsAddr {
+				if reason := tainted[ref.X]; reason != "" {
+					exprs[ast.Unparen(ref.Expr)] = reason
+				}
+			}
+		}
+	}
+}
+
+// taint returns the reason v is sensitive, or "" if it is not (yet known to be).
+func (t *tracker) taint(v ssa.Value, tainted map[ssa.Value]string) string {
+	switch v := v.(type) {
+	case *ssa.Call:
+		return t.taintCall(v.Common(), tainted)
+	case *ssa.UnOp:
+		if v.Op != token.MUL {
+			return ""
+		}
+		if fa, ok := v.X.(*ssa.FieldAddr); ok {
+			if name := fieldName(fa.X.Type(), fa.Field); t.isSensitive(name) {
+				return "field " + name
+			}
+			return tainted[fa.X]
+		}
+		return tainted[v.X]
+	case *ssa.Field:
+		if name := fieldName(v.X.Type(), v.Field); t.isSensitive(name) {
+			return "field " + name
+		}
+		return tainted[v.X]
+	case *ssa.Phi:
+		for _, edge := range v.Edges {
+			if reason := tainted[edge]; reason != "" {
+				return reason
+			}
+		}
+	case *ssa.BinOp:
+		if v.Op == token.ADD {
+			if reason := tainted[v.X]; reason != "" {
+				return reason
+			}
+			return tainted[v.Y]
+		}
+	case *ssa.Extract:
+		return tainted[v.Tuple]
+	case *ssa.MakeInterface:
+		return tainted[v.X]
+	case *ssa.ChangeType:
+		return tainted[v.X]
+	case *ssa.Convert:
+		return tainted[v.X]
+	case *ssa.ChangeInterface:
+		return tainted[v.X]
+	case *ssa.TypeAssert:
+		return tainted[v.X]
+	case *ssa.Slice:
+		return tainted[v.X]
+	case *ssa.Index:
+		return tainted[v.X]
+	case *ssa.IndexAddr:
+		return tainted[v.X]
+	case *ssa.Lookup:
+		return tainted[v.X]
+	}
+	return ""
+}
+
+// taintCall returns the reason the result of a call is sensitive.
+func (t *tracker) taintCall(call *ssa.CallCommon, tainted map[ssa.Value]string) string {
+	var callee *types.Func
+	if call.IsInvoke() {
+		callee = call.Method
+	} else if fn := call.StaticCallee(); fn != nil {
+		callee, _ = fn.Object().(*types.Func)
+	}
+	if callee == nil {
+		return ""
+	}
+	callee = callee.Origin()
+
+	if t.isSensitive(callee.Name()) {
+		return callee.Name() + "()"
+	}
+
+	if t.sources[callee.FullName()] {
+		for _, arg := range call.Args {
+			c, ok := arg.(*ssa.Const)
+			if ok && c.Value != nil && c.Value.Kind() == constant.String && t.isSensitive(constant.StringVal(c.Value)) {
+				return fmt.Sprintf("%s(%s)", callee.Name(), strconv.Quote(constant.StringVal(c.Value)))
+			}
+		}
+		return ""
+	}
+
+	if propagates(callee) {
+		for _, arg := range call.Args {
+			if reason := tainted[arg]; reason != "" {
+				return reason
+			}
+		}
+	}
+	return ""
+}
+
+// propagates reports whether the result of fn carries over the sensitive
+// data of its arguments, as for string formatting and manipulation.
+func propagates(fn *types.Func) bool {
+	if fn.Pkg() == nil {
+		return false
+	}
+	switch fn.Pkg().Path() {
+	case "fmt":
+		return strings.HasPrefix(fn.Name(), "Sprint") || strings.HasPrefix(fn.Name(), "Append")
+	case "strings", "bytes", "strconv":
+		sig := fn.Type().(*types.Signature)
+		if sig.Results().Len() == 0 {
+			return false
+		}
+		switch u := sig.Results().At(0).Type().Underlying().(type) {
+		case *types.Basic:
+			return u.Info()&types.IsString != 0
+		case *types.Slice:
+			return true
+		}
+	}
+	return false
+}
+
+func (t *tracker) isSensitive(s string) bool {
+	s = strings.ToLower(s)
+	for _, kw := range t.keywords {
+		if strings.Contains(s, kw) {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldName returns the name of field i of the struct type t, or *t.
+func fieldName(t types.Type, i int) string {
+	if ptr, ok := t.Underlying().(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	st, ok := t.Underlying().(*types.Struct)
+	if !ok || i >= st.NumFields() {
+		return ""
+	}
+	return st.Field(i).Name()
+}
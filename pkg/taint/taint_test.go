@@ -0,0 +1,49 @@
+package taint_test
+
+import (
+	"go/ast"
+	"path/filepath"
+	"testing"
+
+	"github.com/AlexanderGhosty/log-linter/pkg/taint"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+// TestTaint reports the source of every tainted argument of calls to sink.
+func TestTaint(t *testing.T) {
+	testdata, err := filepath.Abs("../../testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	taintAnalyzer := taint.New([]string{"password", "secret"}, nil)
+	sinkAnalyzer := &analysis.Analyzer{
+		Name:     "sink",
+		Doc:      "reports tainted arguments of sink calls",
+		Requires: []*analysis.Analyzer{taintAnalyzer},
+		Run: func(pass *analysis.Pass) (any, error) {
+			res := pass.ResultOf[taintAnalyzer].(*taint.Result)
+			for _, file := range pass.Files {
+				ast.Inspect(file, func(n ast.Node) bool {
+					call, ok := n.(*ast.CallExpr)
+					if !ok {
+						return true
+					}
+					if ident, ok := call.Fun.(*ast.Ident); !ok || ident.Name != "sink" {
+						return true
+					}
+					for _, arg := range call.Args {
+						if source := res.Source(arg); source != "" {
+							pass.Reportf(arg.Pos(), "%s", source)
+						}
+					}
+					return true
+				})
+			}
+			return nil, nil
+		},
+	}
+
+	analysistest.Run(t, testdata, sinkAnalyzer, "taintflow")
+}
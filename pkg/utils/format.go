@@ -0,0 +1,123 @@
+package utils
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// FormatVerb describes a single directive (e.g. "%-5s" or "%[2]v") within a printf-style format string.
+type FormatVerb struct {
+	// StarArgs holds the operand indices consumed by '*' width or precision.
+	StarArgs []int
+	// Start and End are the byte offsets of the directive within the format string.
+	Start, End int
+	// ArgIndex is the 0-based index of the operand formatted by the verb,
+	// relative to the first argument after the format string. It is -1 for "%%".
+	ArgIndex int
+	// Verb is the verb rune (e.g. 's', 'v', '%').
+	Verb rune
+}
+
+// PrintsOperand reports whether the verb writes the value of its operand to the output.
+// %T and %p only print the operand's type or address.
+func (v FormatVerb) PrintsOperand() bool {
+	return v.ArgIndex >= 0 && v.Verb != 'T' && v.Verb != 'p'
+}
+
+// ParseFormat returns the directives of a printf-style format string, binding each
+// verb to its operand the same way the fmt package does (including explicit
+// argument indexes such as "%[2]d" and '*' width/precision operands).
+func ParseFormat(format string) []FormatVerb {
+	var verbs []FormatVerb
+	argNum := 0
+
+	for i := 0; i < len(format); {
+		if format[i] != '%' {
+			i++
+			continue
+		}
+
+		v := FormatVerb{Start: i}
+		i++
+
+		// Flags
+		for i < len(format) && strings.IndexByte("+-# 0", format[i]) >= 0 {
+			i++
+		}
+
+		parseArgIndex := func() {
+			if i >= len(format) || format[i] != '[' {
+				return
+			}
+			closing := strings.IndexByte(format[i:], ']')
+			if closing < 0 {
+				return
+			}
+			if n, err := strconv.Atoi(format[i+1 : i+closing]); err == nil && n > 0 {
+				argNum = n - 1
+			}
+			i += closing + 1
+		}
+
+		parseNum := func() {
+			parseArgIndex()
+			if i < len(format) && format[i] == '*' {
+				v.StarArgs = append(v.StarArgs, argNum)
+				argNum++
+				i++
+				return
+			}
+			for i < len(format) && format[i] >= '0' && format[i] <= '9' {
+				i++
+			}
+		}
+
+		// Width
+		parseNum()
+		// Precision
+		if i < len(format) && format[i] == '.' {
+			i++
+			parseNum()
+		}
+		parseArgIndex()
+
+		if i >= len(format) {
+			// Missing verb at the end of the string.
+			break
+		}
+
+		r, size := utf8.DecodeRuneInString(format[i:])
+		i += size
+
+		v.Verb = r
+		v.End = i
+		if r == '%' {
+			v.ArgIndex = -1
+		} else {
+			v.ArgIndex = argNum
+			argNum++
+		}
+		verbs = append(verbs, v)
+	}
+
+	return verbs
+}
+
+// formatDirectiveRe matches a likely printf directive. It mirrors the heuristic used
+// by go vet for Print-style calls: the space flag is excluded so that text such as
+// "100% done" is not mistaken for a "% d" directive.
+var formatDirectiveRe = regexp.MustCompile(`%[+\-#]*(\[\d+\])?(\d+|\*)?\.?(\d+|\*)?(\[\d+\])?[bcdefgopqstvxEFGTUX]`)
+
+// IndexFormatDirective returns the byte range of the first likely printf
+// directive in s, or -1, -1 if there is none.
+func IndexFormatDirective(s string) (int, int) {
+	// Escaped percent signs are blanked out with bytes of the same length so
+	// that offsets into s are kept.
+	loc := formatDirectiveRe.FindStringIndex(strings.ReplaceAll(s, "%%", "\x00\x00"))
+	if loc == nil {
+		return -1, -1
+	}
+	return loc[0], loc[1]
+}
@@ -0,0 +1,89 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		want   []FormatVerb
+	}{
+		{
+			name:   "no verbs",
+			format: "plain message",
+			want:   nil,
+		},
+		{
+			name:   "sequential verbs",
+			format: "user %s id %d",
+			want: []FormatVerb{
+				{Start: 5, End: 7, Verb: 's', ArgIndex: 0},
+				{Start: 11, End: 13, Verb: 'd', ArgIndex: 1},
+			},
+		},
+		{
+			name:   "flags and percent literal",
+			format: "%+v 100%%",
+			want: []FormatVerb{
+				{Start: 0, End: 3, Verb: 'v', ArgIndex: 0},
+				{Start: 7, End: 9, Verb: '%', ArgIndex: -1},
+			},
+		},
+		{
+			name:   "explicit index",
+			format: "%[2]s %[1]s",
+			want: []FormatVerb{
+				{Start: 0, End: 5, Verb: 's', ArgIndex: 1},
+				{Start: 6, End: 11, Verb: 's', ArgIndex: 0},
+			},
+		},
+		{
+			name:   "star width",
+			format: "%*d",
+			want: []FormatVerb{
+				{Start: 0, End: 3, Verb: 'd', ArgIndex: 1, StarArgs: []int{0}},
+			},
+		},
+		{
+			name:   "trailing percent",
+			format: "50%",
+			want:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseFormat(tt.format)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseFormat(%q) = %+v, want %+v", tt.format, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIndexFormatDirective(t *testing.T) {
+	tests := []struct {
+		msg        string
+		start, end int
+	}{
+		{"user %s logged in", 5, 7},
+		{"config %+v", 7, 10},
+		{"value %[1]d", 6, 11},
+		{"100%% of %d", 9, 11},
+		{"progress 100% done", -1, -1},
+		{"escaped %%s", -1, -1},
+		{"no directives", -1, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.msg, func(t *testing.T) {
+			start, end := IndexFormatDirective(tt.msg)
+			if start != tt.start || end != tt.end {
+				t.Errorf("IndexFormatDirective(%q) = %d, %d, want %d, %d", tt.msg, start, end, tt.start, tt.end)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package utils
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// KeyStyle is a naming convention for log attribute keys.
+type KeyStyle struct {
+	pattern *regexp.Regexp
+	convert func(key string) string
+	// Name is the name of the convention (e.g. "snake_case").
+	Name string
+}
+
+// keyStyles maps names to key naming conventions.
+var keyStyles = map[string]KeyStyle{
+	"snake_case": {
+		Name:    "snake_case",
+		pattern: regexp.MustCompile(`^[a-z][a-z0-9]*(_[a-z0-9]+)*$`),
+		convert: func(key string) string { return joinWords(key, "_") },
+	},
+	"kebab-case": {
+		Name:    "kebab-case",
+		pattern: regexp.MustCompile(`^[a-z][a-z0-9]*(-[a-z0-9]+)*$`),
+		convert: func(key string) string { return joinWords(key, "-") },
+	},
+	"camelCase": {
+		Name:    "camelCase",
+		pattern: regexp.MustCompile(`^[a-z][a-z0-9]*([A-Z][a-z0-9]*)*$`),
+		convert: toCamelCase,
+	},
+	"dotted": {
+		Name:    "dotted",
+		pattern: regexp.MustCompile(`^[a-z][a-z0-9_]*(\.[a-z][a-z0-9_]*)*$`),
+		convert: toDotted,
+	},
+}
+
+// LookupKeyStyle returns the key naming convention with the given name:
+// "snake_case" (user_id), "camelCase" (userId), "kebab-case" (user-id) or
+// "dotted" (http.status_code, dot-separated snake_case namespaces).
+func LookupKeyStyle(name string) (KeyStyle, bool) {
+	style, ok := keyStyles[name]
+	return style, ok
+}
+
+// Match reports whether key follows the convention.
+func (s KeyStyle) Match(key string) bool {
+	return s.pattern.MatchString(key)
+}
+
+// Convert rewrites key in the convention. It returns "" if the key has no
+// words to convert or the result still does not follow the convention.
+func (s KeyStyle) Convert(key string) string {
+	converted := s.convert(key)
+	if !s.Match(converted) {
+		return ""
+	}
+	return converted
+}
+
+// SplitWords splits an identifier into lowercase words at separators and
+// camelCase boundaries: "userID", "user_id" and "UserId" all yield "user" and
+// "id", and "HTTPServer" yields "http" and "server".
+func SplitWords(s string) []string {
+	var words []string
+	var word strings.Builder
+	flush := func() {
+		if word.Len() > 0 {
+			words = append(words, strings.ToLower(word.String()))
+			word.Reset()
+		}
+	}
+
+	var prev rune
+	for i, r := range s {
+		switch {
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			flush()
+		case unicode.IsUpper(r) && (unicode.IsLower(prev) || unicode.IsDigit(prev)):
+			// "userId": split before "I".
+			flush()
+		case unicode.IsUpper(r) && unicode.IsUpper(prev):
+			// "HTTPServer": split before "S" if a lowercase letter follows.
+			if next, _ := utf8.DecodeRuneInString(s[i+utf8.RuneLen(r):]); unicode.IsLower(next) {
+				flush()
+			}
+		}
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			word.WriteRune(r)
+		}
+		prev = r
+	}
+	flush()
+	return words
+}
+
+func joinWords(key, sep string) string {
+	return strings.Join(SplitWords(key), sep)
+}
+
+func toCamelCase(key string) string {
+	words := SplitWords(key)
+	for i := 1; i < len(words); i++ {
+		r, size := utf8.DecodeRuneInString(words[i])
+		words[i] = string(unicode.ToUpper(r)) + words[i][size:]
+	}
+	return strings.Join(words, "")
+}
+
+// toDotted keeps the namespaces of key and converts each to snake_case.
+func toDotted(key string) string {
+	var parts []string
+	for _, part := range strings.Split(key, ".") {
+		if part = joinWords(part, "_"); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ".")
+}
@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitWords(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []string
+	}{
+		{input: "user_id", want: []string{"user", "id"}},
+		{input: "userID", want: []string{"user", "id"}},
+		{input: "UserId", want: []string{"user", "id"}},
+		{input: "HTTPServer", want: []string{"http", "server"}},
+		{input: "http.status-code", want: []string{"http", "status", "code"}},
+		{input: "ipV4addr", want: []string{"ip", "v4addr"}},
+		{input: "retry2Count", want: []string{"retry2", "count"}},
+		{input: "__", want: nil},
+	}
+
+	for _, tt := range tests {
+		if got := SplitWords(tt.input); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("SplitWords(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestKeyStyle(t *testing.T) {
+	tests := []struct {
+		style     string
+		key       string
+		converted string
+		match     bool
+	}{
+		{style: "snake_case", key: "user_id", match: true, converted: "user_id"},
+		{style: "snake_case", key: "userID", match: false, converted: "user_id"},
+		{style: "snake_case", key: "User-Name", match: false, converted: "user_name"},
+		{style: "snake_case", key: "2fa", match: false, converted: ""},
+		{style: "camelCase", key: "userId", match: true, converted: "userId"},
+		{style: "camelCase", key: "user_id", match: false, converted: "userId"},
+		{style: "kebab-case", key: "user-id", match: true, converted: "user-id"},
+		{style: "kebab-case", key: "UserID", match: false, converted: "user-id"},
+		{style: "dotted", key: "http.status_code", match: true, converted: "http.status_code"},
+		{style: "dotted", key: "http.statusCode", match: false, converted: "http.status_code"},
+		{style: "dotted", key: "user", match: true, converted: "user"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.style+"/"+tt.key, func(t *testing.T) {
+			style, ok := LookupKeyStyle(tt.style)
+			if !ok {
+				t.Fatalf("LookupKeyStyle(%q) failed", tt.style)
+			}
+			if got := style.Match(tt.key); got != tt.match {
+				t.Errorf("Match(%q) = %v, want %v", tt.key, got, tt.match)
+			}
+			if got := style.Convert(tt.key); got != tt.converted {
+				t.Errorf("Convert(%q) = %q, want %q", tt.key, got, tt.converted)
+			}
+		})
+	}
+
+	if _, ok := LookupKeyStyle("PascalCase"); ok {
+		t.Errorf("LookupKeyStyle(%q) succeeded, want failure", "PascalCase")
+	}
+}
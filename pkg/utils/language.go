@@ -0,0 +1,101 @@
+package utils
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Language describes the letters a natural language is written with.
+type Language struct {
+	// Name is the English name of the language (e.g. "Russian").
+	Name string
+	// Scripts are the Unicode scripts of the language's letters. English has
+	// none: it is written with ASCII letters only.
+	Scripts []*unicode.RangeTable
+}
+
+// languages maps ISO 639-1 codes to languages. Languages are only told apart
+// by script, so e.g. all Latin-script languages allow the same letters.
+var languages = map[string]Language{
+	"en": {Name: "English"},
+	"cs": {Name: "Czech", Scripts: []*unicode.RangeTable{unicode.Latin}},
+	"de": {Name: "German", Scripts: []*unicode.RangeTable{unicode.Latin}},
+	"es": {Name: "Spanish", Scripts: []*unicode.RangeTable{unicode.Latin}},
+	"fr": {Name: "French", Scripts: []*unicode.RangeTable{unicode.Latin}},
+	"it": {Name: "Italian", Scripts: []*unicode.RangeTable{unicode.Latin}},
+	"nl": {Name: "Dutch", Scripts: []*unicode.RangeTable{unicode.Latin}},
+	"pl": {Name: "Polish", Scripts: []*unicode.RangeTable{unicode.Latin}},
+	"pt": {Name: "Portuguese", Scripts: []*unicode.RangeTable{unicode.Latin}},
+	"tr": {Name: "Turkish", Scripts: []*unicode.RangeTable{unicode.Latin}},
+	"be": {Name: "Belarusian", Scripts: []*unicode.RangeTable{unicode.Cyrillic}},
+	"bg": {Name: "Bulgarian", Scripts: []*unicode.RangeTable{unicode.Cyrillic}},
+	"ru": {Name: "Russian", Scripts: []*unicode.RangeTable{unicode.Cyrillic}},
+	"uk": {Name: "Ukrainian", Scripts: []*unicode.RangeTable{unicode.Cyrillic}},
+	"el": {Name: "Greek", Scripts: []*unicode.RangeTable{unicode.Greek}},
+	"ar": {Name: "Arabic", Scripts: []*unicode.RangeTable{unicode.Arabic}},
+	"he": {Name: "Hebrew", Scripts: []*unicode.RangeTable{unicode.Hebrew}},
+	"hi": {Name: "Hindi", Scripts: []*unicode.RangeTable{unicode.Devanagari}},
+	"ja": {Name: "Japanese", Scripts: []*unicode.RangeTable{unicode.Han, unicode.Hiragana, unicode.Katakana}},
+	"ko": {Name: "Korean", Scripts: []*unicode.RangeTable{unicode.Hangul, unicode.Han}},
+	"zh": {Name: "Chinese", Scripts: []*unicode.RangeTable{unicode.Han}},
+}
+
+// LookupLanguage returns the language with the given ISO 639-1 code (e.g. "ru").
+func LookupLanguage(code string) (Language, bool) {
+	lang, ok := languages[strings.ToLower(code)]
+	return lang, ok
+}
+
+// ParseRuneRange parses an inclusive range of runes such as "U+00C0-U+00FF"
+// or "À-ÿ", or a single rune such as "U+00E9" or "é".
+func ParseRuneRange(s string) (lo, hi rune, err error) {
+	loStr, hiStr := s, s
+	// The separator is the first '-' after the first rune, so that "--/" is
+	// the range from '-' to '/'.
+	if _, size := utf8.DecodeRuneInString(s); size < len(s) {
+		if i := strings.IndexByte(s[size:], '-'); i >= 0 {
+			loStr, hiStr = s[:size+i], s[size+i+1:]
+		}
+	}
+	if lo, err = parseRune(loStr); err != nil {
+		return 0, 0, fmt.Errorf("invalid rune range %q: %w", s, err)
+	}
+	if hi, err = parseRune(hiStr); err != nil {
+		return 0, 0, fmt.Errorf("invalid rune range %q: %w", s, err)
+	}
+	if lo > hi {
+		return 0, 0, fmt.Errorf("invalid rune range %q: start is after end", s)
+	}
+	return lo, hi, nil
+}
+
+// parseRune parses "U+XXXX" or a single character.
+func parseRune(s string) (rune, error) {
+	if hex, ok := strings.CutPrefix(strings.ToUpper(s), "U+"); ok && len(s) > 2 {
+		n, err := strconv.ParseUint(hex, 16, 32)
+		if err != nil || n > unicode.MaxRune {
+			return 0, fmt.Errorf("invalid code point %q", s)
+		}
+		return rune(n), nil
+	}
+	r, size := utf8.DecodeRuneInString(s)
+	if r == utf8.RuneError || size != len(s) {
+		return 0, fmt.Errorf("expected a single character or U+XXXX, got %q", s)
+	}
+	return r, nil
+}
+
+// MatchPackage reports whether the import path pkgPath matches pattern. A
+// pattern ending in "/..." matches the package and all packages below it;
+// otherwise the pattern is matched with path.Match (e.g. "example.com/*/i18n").
+func MatchPackage(pattern, pkgPath string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "/..."); ok {
+		return pkgPath == prefix || strings.HasPrefix(pkgPath, prefix+"/")
+	}
+	matched, err := path.Match(pattern, pkgPath)
+	return err == nil && matched
+}
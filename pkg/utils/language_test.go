@@ -0,0 +1,65 @@
+package utils
+
+import "testing"
+
+func TestParseRuneRange(t *testing.T) {
+	tests := []struct {
+		input   string
+		lo, hi  rune
+		wantErr bool
+	}{
+		{input: "U+00C0-U+00FF", lo: 0xC0, hi: 0xFF},
+		{input: "u+00e9", lo: 0xE9, hi: 0xE9},
+		{input: "À-ÿ", lo: 'À', hi: 'ÿ'},
+		{input: "é", lo: 'é', hi: 'é'},
+		{input: "--/", lo: '-', hi: '/'},
+		{input: "U+00FF-U+00C0", wantErr: true},
+		{input: "U+ZZZZ", wantErr: true},
+		{input: "ab", wantErr: true},
+		{input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			lo, hi, err := ParseRuneRange(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseRuneRange(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && (lo != tt.lo || hi != tt.hi) {
+				t.Errorf("ParseRuneRange(%q) = %U-%U, want %U-%U", tt.input, lo, hi, tt.lo, tt.hi)
+			}
+		})
+	}
+}
+
+func TestLookupLanguage(t *testing.T) {
+	if lang, ok := LookupLanguage("RU"); !ok || lang.Name != "Russian" {
+		t.Errorf("LookupLanguage(%q) = %v, %v, want Russian", "RU", lang, ok)
+	}
+	if _, ok := LookupLanguage("xx"); ok {
+		t.Errorf("LookupLanguage(%q) succeeded, want failure", "xx")
+	}
+}
+
+func TestMatchPackage(t *testing.T) {
+	tests := []struct {
+		pattern string
+		pkgPath string
+		want    bool
+	}{
+		{pattern: "example.com/app", pkgPath: "example.com/app", want: true},
+		{pattern: "example.com/app", pkgPath: "example.com/app/i18n", want: false},
+		{pattern: "example.com/app/...", pkgPath: "example.com/app", want: true},
+		{pattern: "example.com/app/...", pkgPath: "example.com/app/i18n", want: true},
+		{pattern: "example.com/app/...", pkgPath: "example.com/application", want: false},
+		{pattern: "example.com/*/i18n", pkgPath: "example.com/web/i18n", want: true},
+		{pattern: "example.com/*/i18n", pkgPath: "example.com/web/sub/i18n", want: false},
+		{pattern: "example.com/[", pkgPath: "example.com/[", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := MatchPackage(tt.pattern, tt.pkgPath); got != tt.want {
+			t.Errorf("MatchPackage(%q, %q) = %v, want %v", tt.pattern, tt.pkgPath, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package utils
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Literal maps the bytes of the value of a Go string literal to its source,
+// so that diagnostics and fixes can address part of the literal, whether it
+// is a raw string or uses escape sequences.
+type Literal struct {
+	value string
+	// spans holds, for each byte of value, the source range [start, end),
+	// relative to the start of the literal, of the character or escape
+	// sequence it is part of.
+	spans [][2]int
+	pos   token.Pos
+	size  int
+	raw   bool
+}
+
+// ParseLiteral parses a string literal. It returns false for other literals.
+func ParseLiteral(lit *ast.BasicLit) (*Literal, bool) {
+	src := lit.Value
+	if lit.Kind != token.STRING || len(src) < 2 {
+		return nil, false
+	}
+
+	l := &Literal{pos: lit.Pos(), size: len(src), raw: src[0] == '`'}
+	var value strings.Builder
+	if l.raw {
+		for i := 1; i < len(src)-1; i++ {
+			// Carriage returns are discarded from raw strings.
+			if src[i] == '\r' {
+				continue
+			}
+			value.WriteByte(src[i])
+			l.spans = append(l.spans, [2]int{i, i + 1})
+		}
+		l.value = value.String()
+		return l, true
+	}
+
+	s, offset := src[1:len(src)-1], 1
+	for len(s) > 0 {
+		r, multibyte, tail, err := strconv.UnquoteChar(s, '"')
+		if err != nil {
+			return nil, false
+		}
+		n := len(s) - len(tail)
+		var b []byte
+		if multibyte {
+			b = utf8.AppendRune(nil, r)
+		} else {
+			b = []byte{byte(r)}
+		}
+		// All bytes of a character map to all of its source.
+		for range b {
+			l.spans = append(l.spans, [2]int{offset, offset + n})
+		}
+		value.Write(b)
+		s, offset = tail, offset+n
+	}
+	l.value = value.String()
+	return l, true
+}
+
+// Value returns the value of the literal.
+func (l *Literal) Value() string {
+	return l.value
+}
+
+// Range returns the source range of the value bytes [start, end).
+func (l *Literal) Range(start, end int) (token.Pos, token.Pos) {
+	return l.pos + token.Pos(l.offset(start)), l.pos + token.Pos(l.endOffset(start, end))
+}
+
+// Edit returns a text edit that replaces the value bytes [start, end) with
+// text, written in the style of the literal. It returns false if the range
+// splits an escape sequence or text cannot be written in a raw string.
+func (l *Literal) Edit(start, end int, text string) (analysis.TextEdit, bool) {
+	if !l.boundary(start) || !l.boundary(end) {
+		return analysis.TextEdit{}, false
+	}
+	if l.raw {
+		if strings.ContainsAny(text, "`\r") {
+			return analysis.TextEdit{}, false
+		}
+	} else {
+		text = strconv.Quote(text)
+		text = text[1 : len(text)-1]
+	}
+	pos, endPos := l.Range(start, end)
+	return analysis.TextEdit{Pos: pos, End: endPos, NewText: []byte(text)}, true
+}
+
+// offset returns the source offset of value byte i, or of the closing quote
+// if i is the length of the value.
+func (l *Literal) offset(i int) int {
+	if i >= len(l.spans) {
+		return l.size - 1
+	}
+	return l.spans[i][0]
+}
+
+// endOffset returns the source offset just after value byte end-1, or the
+// offset of start for an empty range.
+func (l *Literal) endOffset(start, end int) int {
+	switch {
+	case end <= start:
+		return l.offset(start)
+	case end > len(l.spans):
+		return l.size - 1
+	}
+	return l.spans[end-1][1]
+}
+
+// boundary reports whether value byte i starts a character or escape
+// sequence of the source (or is the end of the value).
+func (l *Literal) boundary(i int) bool {
+	return i <= 0 || i >= len(l.spans) || l.spans[i][0] != l.spans[i-1][0]
+}
@@ -0,0 +1,95 @@
+package utils
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+)
+
+func parseLiteral(t *testing.T, src string) *Literal {
+	t.Helper()
+	expr, err := parser.ParseExpr(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lit, ok := ParseLiteral(expr.(*ast.BasicLit))
+	if !ok {
+		t.Fatalf("ParseLiteral(%s) failed", src)
+	}
+	return lit
+}
+
+func TestLiteral_Range(t *testing.T) {
+	tests := []struct {
+		src        string
+		value      string
+		want       string // source text of the range
+		start, end int
+	}{
+		{src: `"Hello world"`, value: "Hello world", start: 0, end: 1, want: "H"},
+		{src: "`Hello world`", value: "Hello world", start: 6, end: 11, want: "world"},
+		{src: `"tab\there"`, value: "tab\there", start: 3, end: 4, want: `\t`},
+		{src: `"café ok"`, value: "café ok", start: 3, end: 5, want: `é`},
+		{src: `"café ok"`, value: "café ok", start: 6, end: 8, want: "ok"},
+		{src: `"\x41BC"`, value: "ABC", start: 1, end: 3, want: "BC"},
+		{src: `"end"`, value: "end", start: 3, end: 3, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.src, func(t *testing.T) {
+			lit := parseLiteral(t, tt.src)
+			if lit.Value() != tt.value {
+				t.Fatalf("Value() = %q, want %q", lit.Value(), tt.value)
+			}
+			pos, end := lit.Range(tt.start, tt.end)
+			// The literal starts at Pos 1: offsets are Pos - 1.
+			if got := tt.src[pos-1 : end-1]; got != tt.want {
+				t.Errorf("Range(%d, %d) = %q, want %q", tt.start, tt.end, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLiteral_Edit(t *testing.T) {
+	tests := []struct {
+		src        string
+		text       string
+		want       string // source after the edit, or "" if the edit fails
+		start, end int
+	}{
+		{src: `"Hello world"`, start: 0, end: 1, text: "h", want: `"hello world"`},
+		{src: "`Hello \"world\"`", start: 0, end: 1, text: "h", want: "`hello \"world\"`"},
+		{src: `"done!\n"`, start: 4, end: 5, text: "", want: `"done\n"`},
+		{src: `"say hi"`, start: 4, end: 6, text: "\"hi\"\t", want: `"say \"hi\"\t"`},
+		{src: "`raw`", start: 0, end: 3, text: "a`b", want: ""},
+		{src: `"café"`, start: 3, end: 4, text: "e", want: ""},
+		{src: `"café"`, start: 3, end: 5, text: "e", want: `"cafe"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.src, func(t *testing.T) {
+			lit := parseLiteral(t, tt.src)
+			edit, ok := lit.Edit(tt.start, tt.end, tt.text)
+			if !ok {
+				if tt.want != "" {
+					t.Fatalf("Edit(%d, %d, %q) failed", tt.start, tt.end, tt.text)
+				}
+				return
+			}
+			if tt.want == "" {
+				t.Fatalf("Edit(%d, %d, %q) succeeded, want failure", tt.start, tt.end, tt.text)
+			}
+			got := tt.src[:edit.Pos-1] + string(edit.NewText) + tt.src[edit.End-1:]
+			if got != tt.want {
+				t.Errorf("Edit(%d, %d, %q) = %s, want %s", tt.start, tt.end, tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseLiteral_NotString(t *testing.T) {
+	if _, ok := ParseLiteral(&ast.BasicLit{Kind: token.INT, Value: "42"}); ok {
+		t.Error("ParseLiteral succeeded for an integer literal")
+	}
+}
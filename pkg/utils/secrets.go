@@ -0,0 +1,114 @@
+package utils
+
+import (
+	"math"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// secretFormats are well-known formats of credentials, checked in order.
+var secretFormats = []struct {
+	re   *regexp.Regexp
+	kind string
+}{
+	{re: regexp.MustCompile(`-----BEGIN [A-Z0-9 ]+-----`), kind: "PEM block"},
+	{re: regexp.MustCompile(`eyJ[A-Za-z0-9_-]{5,}\.eyJ[A-Za-z0-9_-]{5,}\.[A-Za-z0-9_-]*`), kind: "JWT"},
+	{re: regexp.MustCompile(`\b(?:AKIA|ASIA|ABIA|ACCA|AGPA|AIDA|AIPA|ANPA|ANVA|AROA|APKA)[A-Z0-9]{16}\b`), kind: "AWS access key ID"},
+	{re: regexp.MustCompile(`\b[A-Za-z][A-Za-z0-9+.-]*://[^/\s:@]*:[^/\s@]+@`), kind: "URL with credentials"},
+	{re: regexp.MustCompile(`(?i)\bbearer\s+[A-Za-z0-9._~+/-]{8,}=*`), kind: "bearer token"},
+	{re: regexp.MustCompile(`(?i)\bbasic\s+[A-Za-z0-9+/]{8,}={0,2}`), kind: "basic auth credentials"},
+}
+
+var uuidRe = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// FindSecret returns a short description of the kind of secret found in s
+// (e.g. "JWT") and its byte range in s, or "", -1, -1 if s does not look
+// like it contains one.
+//
+// Besides well-known formats, any token of at least minLength characters
+// that mixes letters and digits and has a Shannon entropy of at least
+// minEntropy bits per character is reported as a high-entropy string,
+// unless it looks like a UUID, a file path or a URL. A minLength of 0
+// disables the entropy check; note that the secrets rule replaces zero
+// thresholds with its defaults.
+func FindSecret(s string, minEntropy float64, minLength int) (kind string, start, end int) {
+	for _, f := range secretFormats {
+		if loc := f.re.FindStringIndex(s); loc != nil {
+			return f.kind, loc[0], loc[1]
+		}
+	}
+
+	if minLength <= 0 {
+		return "", -1, -1
+	}
+	separator := func(r rune) bool {
+		return unicode.IsSpace(r) || strings.ContainsRune("\"'`,;:=()[]{}<>|&?", r)
+	}
+	for i := 0; i < len(s); {
+		start := strings.IndexFunc(s[i:], func(r rune) bool { return !separator(r) })
+		if start < 0 {
+			break
+		}
+		start += i
+		end := strings.IndexFunc(s[start:], separator)
+		if end < 0 {
+			end = len(s)
+		} else {
+			end += start
+		}
+		i = end
+
+		tok := s[start:end]
+		if len(tok) < minLength || !isMixedAlphanumeric(tok) || isIdentifierLike(tok) {
+			continue
+		}
+		if ShannonEntropy(tok) >= minEntropy {
+			return "high-entropy string", start, end
+		}
+	}
+	return "", -1, -1
+}
+
+// ShannonEntropy returns the Shannon entropy of s in bits per byte.
+func ShannonEntropy(s string) float64 {
+	if s == "" {
+		return 0
+	}
+	var counts [256]int
+	for i := 0; i < len(s); i++ {
+		counts[s[i]]++
+	}
+	entropy := 0.0
+	n := float64(len(s))
+	for _, c := range counts {
+		if c == 0 {
+			continue
+		}
+		p := float64(c) / n
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
+
+// isIdentifierLike reports whether a token is a well-known kind of non-secret
+// identifier: a UUID or a file path (which includes the path of a URL, since
+// tokens are split at ':').
+func isIdentifierLike(s string) bool {
+	return uuidRe.MatchString(s) || strings.HasPrefix(s, "/") || strings.ContainsRune(s, '\\')
+}
+
+// isMixedAlphanumeric reports whether s contains both ASCII letters and digits.
+func isMixedAlphanumeric(s string) bool {
+	var letter, digit bool
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= '0' && c <= '9':
+			digit = true
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z':
+			letter = true
+		}
+	}
+	return letter && digit
+}
@@ -2,7 +2,6 @@ package plugin
 
 import (
 	"github.com/golangci/plugin-module-register/register"
-	"github.com/mitchellh/mapstructure"
 	"golang.org/x/tools/go/analysis"
 
 	"github.com/AlexanderGhosty/log-linter/pkg/analyzer"
@@ -14,14 +13,14 @@ func init() {
 }
 
 func New(conf any) (register.LinterPlugin, error) {
-	var cfg config.Config
-	if err := mapstructure.Decode(conf, &cfg); err != nil {
+	cfg, err := config.Decode(conf)
+	if err != nil {
 		return nil, err
 	}
-	if err := cfg.Validate(); err != nil {
+	if err := cfg.ValidateRuleNames(analyzer.RuleNames()); err != nil {
 		return nil, err
 	}
-	return &Plugin{cfg: cfg}, nil
+	return &Plugin{cfg: *cfg}, nil
 }
 
 type Plugin struct {
@@ -0,0 +1,11 @@
+package baselinecheck // want `baseline entry for rule "english" no longer occurs \(1 stale, fingerprint [0-9a-f]+\)` `baseline entry for rule "symbols" no longer occurs`
+
+import "log/slog"
+
+func baselined() {
+	slog.Info("Legacy message")
+	slog.Info("Legacy message")
+	slog.Info("Legacy message")  // want "log message should start with a lowercase letter"
+	slog.Info("New message")     // want "log message should start with a lowercase letter"
+	slog.Info("legacy message!") // want "log message should not contain special characters or emoji"
+}
@@ -0,0 +1,9 @@
+package app
+
+import "customzap"
+
+func Calls(err error) {
+	customzap.Info("Starting server")                             // want "log message should start with a lowercase letter"
+	customzap.Info("starting server", customzap.String("k", "v")) // OK
+	_ = customzap.Error(err)                                      // OK: a field constructor
+}
@@ -0,0 +1,9 @@
+package englishcheck
+
+import "log/slog"
+
+func Log() {
+	slog.Info("café opened")
+	slog.Info("über alles") // want `log message should be in English`
+	slog.Info("opened", "café", 1)
+}
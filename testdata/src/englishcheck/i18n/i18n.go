@@ -0,0 +1,9 @@
+package i18n
+
+import "log/slog"
+
+func Log(port int) {
+	slog.Info("запуск сервера", "port", port)
+	slog.Info("starting server") // want `log message should be in Russian`
+	slog.Info("café opened")     // want `log message should be in Russian`
+}
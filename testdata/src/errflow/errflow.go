@@ -0,0 +1,122 @@
+package errflow
+
+import (
+	"errors"
+	"fmt"
+)
+
+func sink(...any) {}
+
+func load() (string, error) { return "", nil }
+
+type QueryError struct {
+	Err   error
+	Query string
+}
+
+func (e *QueryError) Error() string { return e.Query + ": " + e.Err.Error() }
+
+func Returned() error {
+	_, err := load()
+	if err != nil {
+		sink(err) // want `returned`
+		return err
+	}
+	return nil
+}
+
+func Wrapped() (string, error) {
+	v, err := load()
+	if err != nil {
+		sink("loading", err) // want `returned`
+		return "", fmt.Errorf("loading: %w", err)
+	}
+	return v, nil
+}
+
+func WrappedInStruct(q string) error {
+	_, err := load()
+	if err != nil {
+		sink(err.Error()) // want `returned`
+		return &QueryError{Query: q, Err: err}
+	}
+	return nil
+}
+
+func Joined(n int) error {
+	var errs []error
+	for i := 0; i < n; i++ {
+		if _, err := load(); err != nil {
+			sink(err) // want `returned`
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func ReturnedLater() error {
+	_, err := load()
+	if err != nil {
+		sink(err) // want `returned`
+	}
+	return err
+}
+
+func Handled() error {
+	_, err := load()
+	if err != nil {
+		sink(err)
+		return nil
+	}
+	return nil
+}
+
+func Replaced() error {
+	_, err := load()
+	if err != nil {
+		sink(err)
+		err = nil
+	}
+	return err
+}
+
+func OtherError() error {
+	_, err := load()
+	if err != nil {
+		sink(err)
+		return errors.New("loading failed")
+	}
+	_, err = load()
+	return err
+}
+
+func OtherPath(retry bool) error {
+	_, err := load()
+	if retry {
+		return err
+	}
+	sink(err)
+	return nil
+}
+
+func Closure() func() error {
+	return func() error {
+		_, err := load()
+		sink(err) // want `returned`
+		return err
+	}
+}
+
+func Exempt() error {
+	_, err := load()
+	sink(err)
+	return err
+}
+
+func ExemptClosure() func() error {
+	return func() error {
+		_, err := load()
+		sink(err)
+		return err
+	}
+}
@@ -0,0 +1,48 @@
+package errorcheck
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+type loadError struct{}
+
+func (*loadError) Error() string { return "load failed" }
+
+// causeError is an error type that errors are converted to.
+type causeError interface{ error }
+
+func Slog(ctx context.Context, logger *slog.Logger, id string, err error, lerr *loadError) {
+	slog.Error("failed to load user", "error", err)             // OK
+	slog.Error("failed to load user", slog.Any("error", err))   // OK
+	slog.Info("loaded", "user", id)                             // OK
+	slog.Error("failed to load user", "error", error(lerr))     // OK: converted to an error, not a string
+	slog.Error("failed to load user", "error", causeError(err)) // OK
+
+	slog.Error("failed to load user: " + err.Error())                    // want `error err is formatted into the log message; log it as an attribute: "error", err`
+	slog.Error(fmt.Sprintf("failed to load user %s: %v", id, err))       // want `error err is formatted into the log message`
+	logger.ErrorContext(ctx, fmt.Sprint("load failed: ", err), "id", id) // want `error err is formatted into the log message`
+	slog.Error("failed", "err", err)                                     // want `error err is logged under key "err"; use "error", err`
+	slog.Error("failed", "error", err.Error())                           // want `error err is logged as a string; use "error", err`
+	slog.Error("failed", "reason", err.Error())                          // want `error err is logged under key "reason"`
+	slog.Error("failed", slog.Any("err", err))                           // want `error err should be logged as slog.Any\("error", err\)`
+	slog.Error("failed", slog.String("error", err.Error()))              // want `error err should be logged as slog.Any\("error", err\)`
+	slog.Error("failed", "id", id, lerr)                                 // want `error lerr is logged without a key; use "error", lerr` `log key lerr has type \*loadError, not string`
+}
+
+func Zap(l *zap.Logger, s *zap.SugaredLogger, err error) {
+	l.Error("failed to load user", zap.Error(err))               // OK
+	l.Error("failed to load user", zap.NamedError("error", err)) // OK
+	s.Errorw("failed to load user", "error", err)                // OK
+
+	l.Error("failed to load user: " + err.Error())         // want `error err is formatted into the log message; log it as an attribute: zap.Error\(err\)`
+	l.Error("failed", zap.Any("err", err))                 // want `error err should be logged as zap.Error\(err\)`
+	l.Error("failed", zap.String("error", err.Error()))    // want `error err should be logged as zap.Error\(err\)`
+	l.Error("failed", zap.NamedError("cause", err))        // want `error err should be logged as zap.Error\(err\)`
+	s.Errorw("failed", "err", err)                         // want `error err is logged under key "err"; use "error", err`
+	s.Errorf("failed to load user: %v", err)               // want `error err is formatted into the log message; log it as an attribute: "error", err with Errorw`
+	s.Errorw("failed to load user: "+err.Error(), "id", 1) // want `error err is formatted into the log message`
+}
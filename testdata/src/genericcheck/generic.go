@@ -0,0 +1,40 @@
+package genericcheck
+
+type Logger struct{}
+
+func NewLogger(name string) *Logger { return &Logger{} }
+
+func (l *Logger) SetLevel(level string)                      {}
+func (l *Logger) Info(msg string, keyvals ...any)            {}
+func (l *Logger) Logf(level int, format string, args ...any) {}
+func (l *Logger) Log(level int, msg string)                  {}
+
+type Console struct{}
+
+func (c *Console) Info(level int, msg string) {}
+
+type Metrics struct{}
+
+func (m *Metrics) Info(name string) {}
+
+func Calls(password string) {
+	l := NewLogger("Main")                // OK (not a configured method)
+	l.SetLevel("Debug")                   // OK (not a configured method)
+	l.Info("Starting server")             // want "log message should start with a lowercase letter"
+	l.Info("login", "password", password) // want "log field key may contain sensitive data" "variable name suggests sensitive data"
+	l.Info("login", "ключ", 1)            // want "log message should be in English"
+	l.Log(1, "Starting server")           // want "log message should start with a lowercase letter"
+	l.Logf(1, "Started %s", "x")          // want "log message should start with a lowercase letter"
+
+	c := &Console{}
+	c.Info(1, "Starting console") // want "log message should start with a lowercase letter"
+
+	m := &Metrics{}
+	m.Info("Requests") // OK (different receiver)
+}
+
+func Formats(password string) {
+	l := NewLogger("main")
+	l.Logf(1, "user %s", password) // want "variable name suggests sensitive data"
+	l.Log(1, "user %s")            // want `log message contains formatting directive "%s" but Log is not a printf-style method`
+}
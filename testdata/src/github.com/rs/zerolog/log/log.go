@@ -0,0 +1,16 @@
+package log
+
+import "github.com/rs/zerolog"
+
+var Logger = zerolog.New()
+
+func Info() *zerolog.Event  { return Logger.Info() }
+func Warn() *zerolog.Event  { return Logger.Warn() }
+func Error() *zerolog.Event { return Logger.Error() }
+func Debug() *zerolog.Event { return Logger.Debug() }
+
+func Err(err error) *zerolog.Event                 { return Logger.Err(err) }
+func WithLevel(level zerolog.Level) *zerolog.Event { return Logger.WithLevel(level) }
+
+func Print(v ...interface{})                 { Logger.Print(v...) }
+func Printf(format string, v ...interface{}) { Logger.Printf(format, v...) }
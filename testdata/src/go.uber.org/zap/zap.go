@@ -1,5 +1,7 @@
 package zap
 
+import "go.uber.org/zap/zapcore"
+
 type Logger struct{}
 
 func NewExample() *Logger { return &Logger{} }
@@ -11,15 +13,22 @@ func (l *Logger) Debug(msg string, fields ...Field) {}
 
 type Field struct{}
 
-func String(key, val string) Field          { return Field{} }
-func Int(key string, val int) Field         { return Field{} }
-func Any(key string, val interface{}) Field { return Field{} }
+func String(key, val string) Field           { return Field{} }
+func Int(key string, val int) Field          { return Field{} }
+func Any(key string, val interface{}) Field  { return Field{} }
+func Error(err error) Field                  { return Field{} }
+func NamedError(key string, err error) Field { return Field{} }
+
+func Object(key string, val zapcore.ObjectMarshaler) Field { return Field{} }
 
 func L() *Logger        { return &Logger{} }
 func S() *SugaredLogger { return &SugaredLogger{} }
 
 type SugaredLogger struct{}
 
-func (s *SugaredLogger) Info(args ...interface{})                       {}
-func (s *SugaredLogger) Infof(template string, args ...interface{})     {}
-func (s *SugaredLogger) Infow(msg string, keysAndValues ...interface{}) {}
+func (s *SugaredLogger) Info(args ...interface{})                        {}
+func (s *SugaredLogger) Infof(template string, args ...interface{})      {}
+func (s *SugaredLogger) Infow(msg string, keysAndValues ...interface{})  {}
+func (s *SugaredLogger) Errorf(template string, args ...interface{})     {}
+func (s *SugaredLogger) Errorw(msg string, keysAndValues ...interface{}) {}
+func (s *SugaredLogger) Debugf(template string, args ...interface{})     {}
@@ -0,0 +1,10 @@
+//loglinter:ignore lowercase,english -- generated from external event catalog
+
+package ignorecheck
+
+import "log/slog"
+
+func Generated() {
+	slog.Info("Запуск")
+	slog.Info("Started!") // want "log message should not contain special characters or emoji"
+}
@@ -0,0 +1,47 @@
+package ignorecheck
+
+import (
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+func LineLevel(hash string) {
+	slog.Info("Starting server") //loglinter:ignore lowercase -- matches upstream wording
+	slog.Info("Starting server") //loglinter:ignore english -- wrong rule // want "log message should start with a lowercase letter" "unused //loglinter:ignore directive for english"
+
+	//loglinter:ignore sensitive -- value is already hashed
+	slog.Info("login", "password", hash)
+
+	//loglinter:ignore sensitive,symbols -- covers the whole statement
+	zap.S().Infow("server started",
+		"token!", hash,
+	)
+
+	slog.Info("Login", "password", hash) //loglinter:ignore all -- legacy call site
+}
+
+// FunctionLevel is excluded from the lowercase rule.
+//
+//loglinter:ignore lowercase -- messages mirror external event names
+func FunctionLevel() {
+	slog.Info("Started")
+	slog.Info("Stopped")
+	slog.Info("stopped!") // want "log message should not contain special characters or emoji"
+}
+
+func Malformed() {
+	//loglinter:ignore lowercase // want "malformed //loglinter:ignore directive: missing reason"
+	slog.Info("Starting server") // want "log message should start with a lowercase letter"
+
+	//loglinter:ignore typo -- reason // want "malformed //loglinter:ignore directive: unknown rule \"typo\""
+	slog.Info("Starting server") // want "log message should start with a lowercase letter"
+
+	//loglinter:ignore -- reason // want "malformed //loglinter:ignore directive: missing rule names"
+	slog.Info("Starting server") // want "log message should start with a lowercase letter"
+}
+
+func Unused() {
+	//loglinter:ignore sensitive -- nothing to hide here // want "unused //loglinter:ignore directive for sensitive"
+	slog.Info("starting server")
+}
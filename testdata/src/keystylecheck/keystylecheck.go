@@ -0,0 +1,21 @@
+package keystylecheck
+
+import (
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+const userKey = "userName"
+
+func KeyStyle(logger *zap.Logger, id, name string) {
+	slog.Info("login", "user_id", id, "attempt", 1)
+	slog.Info("login", "userId", id)                             // want `log field key "userId" should be snake_case`
+	slog.Info("login", "User-Name", name)                        // want `log field key "User-Name" should be snake_case`
+	slog.Info("login", userKey, name)                            // want `log field key "userName" should be snake_case`
+	slog.Info("login", slog.String("HTTPStatus", "ok"))          // want `log field key "HTTPStatus" should be snake_case`
+	logger.Info("login", zap.String("requestID", id))            // want `log field key "requestID" should be snake_case`
+	slog.Info("login", "user_id", id, "user_id", name)           // want `duplicate log field key "user_id"`
+	logger.Info("login", zap.String("id", id), zap.Int("id", 1)) // want `duplicate log field key "id"`
+	slog.Info("login", "2fa", true)                              // want `log field key "2fa" should be snake_case`
+}
@@ -0,0 +1,8 @@
+package keystyleregex
+
+import "log/slog"
+
+func KeyStyle(id string) {
+	slog.Info("login", "user.id", id)
+	slog.Info("login", "user_id", id) // want `log field key "user_id" should match pattern \^\[a-z\]`
+}
@@ -0,0 +1,36 @@
+package kvcheck
+
+import (
+	"context"
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+type Key string
+
+func KeyValues(ctx context.Context, logger *slog.Logger, id string, attr slog.Attr, v any, args []any) {
+	slog.Info("login", "user", id, "attempt", 1)
+	slog.Info("login", slog.String("user", id), "attempt", 1, attr)
+	logger.InfoContext(ctx, "login", "user", id, slog.Int("attempt", 1))
+	slog.Info("login", v, id)
+	slog.Info("login", "user", v)
+	slog.Info("login", args...)
+	slog.Info("login", slog.Int("attempt", 1), "password", id) // want `log field key may contain sensitive data`
+
+	slog.Info("login", "user", id, "attempt")         // want `odd number of key-value arguments: key "attempt" has no value \(logged as !BADKEY\)`
+	slog.Info("login", "user", id, id)                // want `odd number of key-value arguments: key id has no value`
+	slog.Info("login", 42, id)                        // want `log key 42 has type int, not string \(logged as !BADKEY\)` `odd number of key-value arguments: key id has no value`
+	slog.Info("login", Key("user"), id, "ok")         // want `log key Key\("user"\) has type Key, not string`
+	slog.Info("login", "user", slog.String("id", id)) // want `slog.Attr slog.String\("id", id\) is used as the value of key "user", which breaks key-value pairing`
+	logger.Warn("login", "user", attr, "attempt", 1)  // want `slog.Attr attr is used as the value of key "user"`
+	slog.Info("login", "attempt", 1, v)               // dynamic: v may be an slog.Attr
+}
+
+func Sugared(s *zap.SugaredLogger, id string) {
+	s.Infow("login", "user", id, zap.Int("attempt", 1))
+	s.Infow("login", "user", id, "attempt")        // want `odd number of key-value arguments: key "attempt" has no value \(ignored\)`
+	s.Infow("login", 42, id, "user", id)           // want `log key 42 has type int, not string \(ignored\)`
+	s.Infow("login", "user", zap.String("id", id)) // want `zap.Field zap.String\("id", id\) is used as the value of key "user"`
+	s.Infof("user %s", id)
+}
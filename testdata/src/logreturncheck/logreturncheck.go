@@ -0,0 +1,142 @@
+package logreturncheck
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/sirupsen/logrus"
+	"go.uber.org/zap"
+)
+
+func load(id string) (string, error) { return id, nil }
+
+func Slog(ctx context.Context, id string) (string, error) {
+	name, err := load(id)
+	if err != nil {
+		slog.Error("failed to load user", "error", err) // want `error err is logged and returned; either handle it here or return it without logging`
+		return "", err
+	}
+
+	if _, err := load(name); err != nil {
+		slog.ErrorContext(ctx, "failed to load user: "+err.Error()) // want `error err is logged and returned`
+		return "", fmt.Errorf("loading %s: %w", name, err)
+	}
+
+	if _, err := load(name); err != nil {
+		slog.Log(ctx, slog.LevelError, "failed to load user", slog.Any("error", err)) // want `error err is logged and returned`
+		return "", err
+	}
+
+	if _, err := load(name); err != nil {
+		slog.Warn("failed to load user", "error", err) // OK: not logged at error level
+		return "", err
+	}
+
+	if _, err := load(name); err != nil {
+		slog.Error("failed to load user", "error", err) // OK: handled here
+		return name, nil
+	}
+	return name, nil
+}
+
+func Zap(logger *zap.Logger, id string) error {
+	_, err := load(id)
+	if err != nil {
+		logger.Error("failed to load user", zap.Error(err)) // want `error err is logged and returned`
+		return err
+	}
+
+	if _, err := load(id); err != nil {
+		zap.S().Errorw("failed to load user", "error", err) // want `error err is logged and returned`
+		return fmt.Errorf("loading: %w", err)
+	}
+	return nil
+}
+
+func Zerolog(logger zerolog.Logger, id string) error {
+	if _, err := load(id); err != nil {
+		logger.Error().Err(err).Msg("failed to load user") // want `error err is logged and returned`
+		return err
+	}
+
+	if _, err := load(id); err != nil {
+		logger.Err(err).Str("user", id).Msg("failed to load user") // want `error err is logged and returned`
+		return err
+	}
+
+	if _, err := load(id); err != nil {
+		log.Err(err).Msg("failed to load user") // want `error err is logged and returned`
+		return err
+	}
+
+	if _, err := load(id); err != nil {
+		logger.WithLevel(zerolog.ErrorLevel).Err(err).Msg("failed to load user") // want `error err is logged and returned`
+		return err
+	}
+
+	if _, err := load(id); err != nil {
+		logger.Info().Err(err).Msg("retrying") // OK: not logged at error level
+		return err
+	}
+
+	if _, err := load(id); err != nil {
+		log.WithLevel(zerolog.WarnLevel).Err(err).Msg("retrying") // OK: not logged at error level
+		return err
+	}
+	return nil
+}
+
+func Logrus(logger *logrus.Logger, id string) error {
+	if _, err := load(id); err != nil {
+		logger.WithError(err).Error("failed to load user") // want `error err is logged and returned`
+		return err
+	}
+
+	if _, err := load(id); err != nil {
+		logger.Log(logrus.ErrorLevel, "failed to load user: ", err) // want `error err is logged and returned`
+		return err
+	}
+
+	if _, err := load(id); err != nil {
+		logger.Logf(logrus.ErrorLevel, "failed to load user: %v", err) // want `error err is logged and returned`
+		return err
+	}
+
+	if _, err := load(id); err != nil {
+		logger.Logf(logrus.WarnLevel, "failed to load user: %v", err) // OK: not logged at error level
+		return err
+	}
+	return nil
+}
+
+func logError(msg string, args ...any) { // want logError:`logwrapper\(log/slog.Error, msg=0, args=1\)`
+	slog.Error(msg, args...)
+}
+
+func Wrapper(id string) error {
+	if _, err := load(id); err != nil {
+		logError("failed to load user", "error", err) // want `error err is logged and returned`
+		return err
+	}
+	return nil
+}
+
+func Suppressed(id string) error {
+	if _, err := load(id); err != nil {
+		slog.Error("failed to load user", "error", err) //loglinter:ignore log-and-return -- callers do not log
+		return err
+	}
+	return nil
+}
+
+// Handle is a top-level handler: it is exempt in the configuration.
+func Handle(id string) error {
+	if _, err := load(id); err != nil {
+		slog.Error("request failed", "error", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package logruscheck
+
+import (
+	"errors"
+
+	"github.com/sirupsen/logrus"
+)
+
+func Messages(user, password string) {
+	logrus.Info("Starting server")           // want "log message should start with a lowercase letter"
+	logrus.Infoln("server started!")         // want "log message should not contain special characters or emoji"
+	logrus.Infof("User %s logged in", user)  // want "log message should start with a lowercase letter"
+	logrus.Errorf("failed: %+v", user)       // OK
+	logrus.Warn("запуск")                    // want "log message should be in English"
+	logrus.Info("user %s logged in", user)   // want `log message contains formatting directive "%s" but Info is not a printf-style method`
+	logrus.Info("login for", user, password) // want "variable name suggests sensitive data"
+	logrus.Infof("login for %s", password)   // want "variable name suggests sensitive data"
+	logrus.SetLevel(logrus.InfoLevel)        // OK (not a log call)
+
+	logger := logrus.New()
+	logger.Log(logrus.InfoLevel, "Started")           // want "log message should start with a lowercase letter"
+	logger.WithError(errors.New("x")).Error("Failed") // want "log message should start with a lowercase letter"
+}
+
+func Fields(user, password string) {
+	logrus.WithField("user", user).Info("login")                        // OK
+	logrus.WithField("password", user).Info("login")                    // want "log field key may contain sensitive data"
+	logrus.WithField("user", password).Info("login")                    // want "variable name suggests sensitive data"
+	logrus.WithField("ключ", user).Info("login")                        // want "log message should be in English"
+	logrus.WithField("user", user).WithField("key!", 1).Warnln("login") // want "log message should not contain special characters or emoji"
+
+	logrus.WithFields(logrus.Fields{
+		"user":  user,
+		"token": "x", // want "log field key may contain sensitive data"
+		"key!":  1,   // want "log message should not contain special characters or emoji"
+	}).Info("login")
+
+	logger := logrus.New()
+	logger.WithFields(logrus.Fields{"secret": user}).Infof("login %s", user) // want "log field key may contain sensitive data"
+}
@@ -0,0 +1,23 @@
+package messagecheck
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"log/slog"
+)
+
+func Messages(name string, prefix string, n int) {
+	slog.Info("Started " + name)                     // want `log message should start with a lowercase letter`
+	slog.Info(prefix + "Started")                    // OK: the message starts with prefix
+	slog.Info(prefix + "started!")                   // want `log message should not contain special characters or emoji`
+	slog.Info("user " + name + " запуск")            // want `log message should be in English`
+	slog.Info(fmt.Sprintf("Started %s", name))       // want `log message should start with a lowercase letter`
+	slog.Info(fmt.Sprintf("started %d%%!", n))       // want `log message should not contain special characters or emoji`
+	slog.Info(fmt.Sprint("Started ", name, "!"))     // want `log message should start with a lowercase letter` `log message should not contain special characters or emoji`
+	slog.Info(errors.New("Failed").Error())          // want `log message should start with a lowercase letter`
+	slog.Info(fmt.Errorf("Failed: %w", nil).Error()) // want `log message should start with a lowercase letter`
+	log.Printf(prefix+"%d tasks 🚀", n)               // want `log message should not contain special characters or emoji`
+
+	slog.Info(fmt.Sprintf(prefix, name))
+}
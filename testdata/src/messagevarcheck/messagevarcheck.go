@@ -0,0 +1,28 @@
+package messagevarcheck
+
+import "log/slog"
+
+var packageMsg = "Package level"
+
+func Variables(name string) {
+	msg := "Request done"
+	slog.Info(msg) // want `log message should start with a lowercase letter`
+	slog.Warn(msg) // want `log message should start with a lowercase letter`
+
+	var head = "Request: "
+	full := head + name
+	slog.Info(full) // want `log message should start with a lowercase letter`
+
+	ignored := "Ignored"
+	slog.Info(ignored) //loglinter:ignore lowercase -- product name
+
+	changed := "Changed"
+	changed = name
+	slog.Info(changed)
+
+	addressed := "Addressed"
+	_ = &addressed
+	slog.Info(addressed)
+
+	slog.Info(packageMsg)
+}
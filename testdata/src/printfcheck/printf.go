@@ -0,0 +1,38 @@
+package printfcheck
+
+import (
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+type Config struct{}
+
+func FormatMessages(id string, cfg Config, width int) {
+	sugar := zap.S()
+
+	// Verbs are not special characters
+	sugar.Infof("loaded config %+v", cfg)       // OK
+	sugar.Infof("user %[1]s (%-5d)", id, width) // OK
+	sugar.Infof("progress: %d%%", width)        // OK
+	sugar.Errorf("loaded config %#v!", cfg)     // want "log message should not contain special characters or emoji"
+	sugar.Infof("Loaded config %v", cfg)        // want "log message should start with a lowercase letter"
+	sugar.Debugf("%s started", id)              // OK
+	sugar.Infof("padded %*d", width, width)     // OK
+	slog.Info("user %s logged in", "id", id)    // want `log message contains formatting directive "%s" but Info is not a printf-style method`
+	slog.Info("progress 100% done")             // OK
+	slog.Info("literal %%s is fine")            // OK
+	sugar.Infow("user %v logged in", "id", id)  // want `log message contains formatting directive "%v" but Infow is not a printf-style method`
+	zap.NewExample().Info("request %d handled") // want `log message contains formatting directive "%d" but Info is not a printf-style method`
+}
+
+func FormatArguments(user string, password string) {
+	sugar := zap.S()
+
+	sugar.Infof("user %s logged in", user)              // OK
+	sugar.Infof("user %s logged in", password)          // want "variable name suggests sensitive data"
+	sugar.Infof("password type is %T", password)        // want "log message may contain sensitive data"
+	sugar.Infof("hash %[2]s for %[1]s", user, password) // want "variable name suggests sensitive data"
+	sugar.Infof("user %s", user, password)              // want "variable name suggests sensitive data"
+	sugar.Infof("user %s", "my_secret_token")           // want "log format argument contains sensitive data"
+}
@@ -0,0 +1,13 @@
+package rangecheck
+
+import "log/slog"
+
+func Literals(id int) {
+	slog.Info(`Raw "quoted" message`)            // want `log message should start with a lowercase letter` `log message should not contain special characters or emoji`
+	slog.Info("Tab\tseparated text")             // want `log message should start with a lowercase letter` `log message should not contain special characters or emoji`
+	slog.Info("Started")                         // want `log message should start with a lowercase letter`
+	slog.Info("Value \x41 set")                  // want `log message should start with a lowercase letter`
+	slog.Info(`done!\n`)                         // want `log message should not contain special characters or emoji`
+	slog.Info("done!!\t🚀 and ... ok\n")          // want `log message should not contain special characters or emoji`
+	slog.Info("received message body", "id", id) // OK
+}
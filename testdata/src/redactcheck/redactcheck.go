@@ -0,0 +1,21 @@
+package redactcheck
+
+import (
+	"log/slog"
+	"net/http"
+
+	"go.uber.org/zap"
+)
+
+type Token string
+
+func Redact(req *http.Request, logger *zap.Logger, password string, tok Token, attempts int) {
+	slog.Info("login", "password", password)          // want `log field key may contain sensitive data` `variable name suggests sensitive data`
+	slog.Info("login", "value", password)             // want `variable name suggests sensitive data`
+	slog.Info("login", "token", tok)                  // want `log field key may contain sensitive data`
+	slog.Info("login", "password_tries", attempts)    // want `log field key may contain sensitive data`
+	logger.Info("login", zap.String("secret", "abc")) // want `log field key may contain sensitive data`
+
+	pw := req.FormValue("password")
+	slog.Info("login", "value", pw) // want `log attribute is derived from sensitive data`
+}
@@ -0,0 +1,17 @@
+package redacthelper
+
+import (
+	"log/slog"
+)
+
+type Token string
+
+func Redact(password string, tok Token) {
+	slog.Info("login", "password", password) // want `log field key may contain sensitive data` `variable name suggests sensitive data`
+	slog.Info("login", "token", tok)         // want `log field key may contain sensitive data`
+}
+
+func Shadowed(password string) {
+	redact := "shadows the package"
+	slog.Info("login", "value", password, "note", redact) // want `variable name suggests sensitive data`
+}
@@ -0,0 +1,9 @@
+package rulescheck
+
+import "log/slog"
+
+func Check() {
+	slog.Info("запуск сервера")         // OK (english rule disabled)
+	slog.Info("Starting server")        // want "^warning: log message should start with a lowercase letter$"
+	slog.Info("login", "password", "x") // want "^log field key may contain sensitive data$"
+}
@@ -0,0 +1,20 @@
+package spellcheck
+
+import (
+	"fmt"
+	"log"
+	"log/slog"
+)
+
+func Spelling(user string, err error) {
+	slog.Info("conection refused")                          // want `log message contains misspelled word "conection" \(did you mean "connection"\?\)`
+	slog.Info("request recieved", "userNmae", user)         // want `log message contains misspelled word "recieved"` `log field key contains misspelled word "Nmae" \(did you mean "Name"\?\)`
+	log.Printf("user %s not fuond: %v", user, err)          // want `log message contains misspelled word "fuond" \(did you mean "found"\?\)`
+	slog.Info("sendng responce")                            // want `log message contains misspelled words "sendng" \(did you mean "sending"\?\), "responce" \(did you mean "response"\?\)`
+	slog.Info("reconnecting to kafka")                      // want `log message contains misspelled words "reconnecting", "kafka" \(did you mean "aka"\?\)`
+	slog.Info("xqzvwk happened")                            // want `log message contains misspelled word "xqzvwk"$`
+	slog.Info("kubernetes pod restarted", "tenant", user)   // user word
+	slog.Info("acmeflow job finished", "grpc_status", "ok") // dictionary file
+	slog.Info("loaded /etc/confg.yaml for userId", "id", 1) // paths and identifiers
+	slog.Info(fmt.Sprint("conection ", user))               // want `log message contains misspelled word "conection"`
+}
@@ -0,0 +1,29 @@
+package stdcheck
+
+import (
+	"log"
+	"os"
+)
+
+func PackageFunctions(user, password string) {
+	log.Print("Starting server")               // want "log message should start with a lowercase letter"
+	log.Println("server started!")             // want "log message should not contain special characters or emoji"
+	log.Printf("Loaded %+v", user)             // want "log message should start with a lowercase letter"
+	log.Printf("user %s logged in", user)      // OK
+	log.Printf("user %s logged in", password)  // want "variable name suggests sensitive data"
+	log.Println("user", user, "logged in")     // OK
+	log.Println("login for", user, password)   // want "variable name suggests sensitive data"
+	log.Println("запуск сервера")              // want "log message should be in English"
+	log.Println("user %s logged in", user)     // want `log message contains formatting directive "%s" but Println is not a printf-style method`
+	log.Fatalf("failed to start: %v", os.Args) // OK
+	log.Panicln("Failed to start")             // want "log message should start with a lowercase letter"
+}
+
+func LoggerMethods() {
+	logger := log.New(os.Stderr, "", log.LstdFlags)
+	logger.SetPrefix("App ")                   // OK (not a log call)
+	logger.Printf("Request %d handled", 42)    // want "log message should start with a lowercase letter"
+	logger.Println("request handled")          // OK
+	logger.Fatalln("Shutting down")            // want "log message should start with a lowercase letter"
+	_ = logger.Output(2, "Called from helper") // want "log message should start with a lowercase letter"
+}
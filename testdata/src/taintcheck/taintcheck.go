@@ -0,0 +1,66 @@
+package taintcheck
+
+import (
+	"fmt"
+	"log"
+	"log/slog"
+	"net/http"
+	"os"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+type User struct {
+	Name     string
+	Password string
+}
+
+type Vault struct{}
+
+func (Vault) LoadSecret(name string) string { return name }
+
+func Login(req *http.Request, logger *zap.Logger, u *User, v Vault) {
+	pw := req.FormValue("password")
+	slog.Info("login", "value", pw)          // want `log attribute is derived from sensitive data \(FormValue\("password"\)\)`
+	slog.Info("login", slog.String("v", pw)) // want `log attribute is derived from sensitive data \(FormValue\("password"\)\)`
+	log.Printf("login with %s", pw)          // want `log format argument is derived from sensitive data \(FormValue\("password"\)\)`
+	log.Printf("login with %T", pw)
+	log.Println("login", pw) // want `log message is derived from sensitive data \(FormValue\("password"\)\)`
+
+	masked := strings.TrimSpace(pw)
+	logger.Info("login", zap.String("value", masked)) // want `log attribute is derived from sensitive data \(FormValue\("password"\)\)`
+
+	line := fmt.Sprintf("user %s logged in with %s", u.Name, pw)
+	slog.Info(line) // want `log message is derived from sensitive data \(FormValue\("password"\)\)`
+
+	name := req.FormValue("name")
+	slog.Info("login", "name", name)
+
+	p := u.Password
+	slog.Info("login", "value", p) // want `log attribute is derived from sensitive data \(field Password\)`
+
+	// Reported by the sensitive rule by its name.
+	slog.Info("login", "value", u.Password) // want `field name suggests sensitive data`
+
+	key := os.Getenv("API_KEY_SECRET")
+	slog.Info("config", "key", key) // want `log attribute is derived from sensitive data \(Getenv\("API_KEY_SECRET"\)\)`
+
+	home := os.Getenv("HOME")
+	slog.Info("config", "home", home)
+
+	s := v.LoadSecret("db")
+	slog.Info("vault", "value", s) // want `log attribute is derived from sensitive data \(LoadSecret\(\)\)`
+
+	var last string
+	for _, h := range []string{"a", "b"} {
+		if h == "b" {
+			last = req.Header.Get("X-Auth-Token")
+		}
+	}
+	slog.Info("header", "last", last) // want `log attribute is derived from sensitive data \(Get\("X-Auth-Token"\)\)`
+
+	func() {
+		slog.Info("closure", "value", pw) // not tracked across functions
+	}()
+}
@@ -0,0 +1,53 @@
+package taintflow
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+func sink(...any) {}
+
+type Config struct {
+	DBPassword string
+	Port       int
+}
+
+func Flow(cfg Config, n int) {
+	secret := os.Getenv("APP_SECRET")
+	sink(secret)                  // want `Getenv\("APP_SECRET"\)`
+	sink("prefix " + secret)      // want `Getenv\("APP_SECRET"\)`
+	sink(strings.ToUpper(secret)) // want `Getenv\("APP_SECRET"\)`
+	sink(fmt.Sprint(n, secret))   // want `Getenv\("APP_SECRET"\)`
+	sink([]byte(secret))          // want `Getenv\("APP_SECRET"\)`
+	sink(len(secret), strconv.Itoa(n))
+	sink(os.Getenv("PATH"))
+
+	value, _ := os.LookupEnv("DB_PASSWORD")
+	sink(value) // want `LookupEnv\("DB_PASSWORD"\)`
+
+	p := cfg.DBPassword
+	sink(p, cfg.Port) // want `field DBPassword`
+
+	var s string
+	if n > 0 {
+		s = secret
+	}
+	sink(s) // want `Getenv\("APP_SECRET"\)`
+
+	ptr := &s
+	sink(*ptr) // want `Getenv\("APP_SECRET"\)`
+}
+
+type Account struct {
+	Name string
+	Key  string
+}
+
+func Fields() {
+	var a Account
+	a.Key = os.Getenv("APP_SECRET")
+	ptr := &a
+	sink(ptr.Name)
+}
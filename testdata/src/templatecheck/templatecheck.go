@@ -0,0 +1,45 @@
+package templatecheck
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"log/slog"
+	"strconv"
+
+	"github.com/rs/zerolog"
+	"github.com/sirupsen/logrus"
+	"go.uber.org/zap"
+)
+
+type User struct {
+	ID   string
+	Name string
+}
+
+func Messages(ctx context.Context, logger *slog.Logger, id string, n int, user User, err error) {
+	slog.Info("user " + id)                                                              // want `log message is built from variable data`
+	slog.Info("user " + id + " logged in")                                               // want `log message is built from variable data`
+	slog.Info(fmt.Sprintf("user %s logged in after %d attempts", id, n))                 // want `log message is built from variable data`
+	logger.ErrorContext(ctx, "failed to load "+user.Name+": "+err.Error(), "attempt", n) // want `log message is built from variable data`
+	slog.Warn(err.Error())                                                               // want `log message is built from variable data`
+	slog.Info(string(err.Error()))                                                       // want `log message is built from variable data`
+	slog.Info(fmt.Sprint("loaded ", n, " users"))                                        // want `log message is built from variable data`
+	slog.Info("user "+id, "id", id)                                                      // want `log message is built from variable data`
+	slog.Info("retry " + strconv.Itoa(n))                                                // want `log message is built from variable data`
+
+	msg := "user logged in"
+	slog.Info(msg)                         // OK: not built here
+	slog.Info("user logged in", "id", id)  // OK
+	slog.Info("user" + " logged in")       // OK: constant
+	log.Print("user " + id)                // OK: not a structured logger
+	zap.S().Infof("user %s logged in", id) // OK: printf-style method
+}
+
+func Loggers(l *zap.Logger, s *zap.SugaredLogger, z zerolog.Logger, id string) {
+	s.Infow("user " + id)      // want `log message is built from variable data`
+	l.Info("user " + id)       // want `log message is built from variable data`
+	z.Info().Msg("user " + id) // want `log message is built from variable data`
+	logrus.Info("user " + id)  // want `log message is built from variable data`
+	logrus.Info("user ", id)   // OK: Print-style arguments
+}
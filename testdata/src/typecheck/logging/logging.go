@@ -0,0 +1,6 @@
+package logging
+
+// Printer is implemented by application log sinks.
+type Printer interface {
+	Print(msg string)
+}
@@ -0,0 +1,22 @@
+package typecheck
+
+import "typecheck/logging"
+
+type console struct{}
+
+func (console) Print(msg string) {}
+
+type unrelated struct{}
+
+func (unrelated) Print(msg string, n int) {}
+
+func Calls(p logging.Printer) {
+	p.Print("Starting server") // want "log message should start with a lowercase letter"
+
+	c := console{}
+	c.Print("Starting server") // want "log message should start with a lowercase letter"
+	(&c).Print("done!")        // want "log message should not contain special characters or emoji"
+
+	u := unrelated{}
+	u.Print("Starting server", 1) // OK (does not implement logging.Printer)
+}
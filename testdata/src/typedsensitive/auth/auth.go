@@ -0,0 +1,8 @@
+package auth
+
+type Token string
+
+type Session struct {
+	ID     string
+	Bearer Token
+}
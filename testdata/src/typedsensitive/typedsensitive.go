@@ -0,0 +1,72 @@
+package typedsensitive
+
+import (
+	"fmt"
+	"log"
+	"log/slog"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"typedsensitive/auth"
+)
+
+type User struct {
+	Name     string
+	Password string
+}
+
+type Account struct {
+	Owner *User
+	ID    int
+}
+
+type Card struct {
+	Holder string
+	Number string `log:"redact"`
+}
+
+type Profile struct {
+	Name    string
+	OnToken func() // functions are not printed
+}
+
+// SafeUser controls its own log representation.
+type SafeUser struct {
+	Name     string
+	Password string
+}
+
+func (u SafeUser) LogValue() slog.Value { return slog.StringValue(u.Name) }
+
+// MarshaledUser controls its own zap representation.
+type MarshaledUser struct {
+	Name     string
+	Password string
+}
+
+func (u *MarshaledUser) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("name", u.Name)
+	return nil
+}
+
+func Values(u User, acc Account, accounts map[string][]*Account, card Card, profile Profile, safe SafeUser, mu *MarshaledUser, tok auth.Token, s auth.Session) {
+	slog.Info("login", "user", u)            // want `logged value of type User contains sensitive field Password`
+	slog.Info("login", "account", &acc)      // want `logged value of type User contains sensitive field Password`
+	slog.Info("login", "accounts", accounts) // want `logged value of type User contains sensitive field Password`
+	slog.Info("payment", "card", card)       // want `logged value of type Card contains sensitive field Number`
+	slog.Info("payment", "holder", card.Holder)
+	slog.Info("profile", "profile", profile)
+	slog.Info("login", "user", safe)
+	slog.Info("login", "token", tok)        // want `log field key may contain sensitive data` `logged value has sensitive type auth.Token`
+	slog.Info("login", "session", s)        // want `logged value has sensitive type auth.Token`
+	slog.Info("login", slog.Any("user", u)) // want `logged value of type User contains sensitive field Password`
+	log.Printf("user %v logged in", u)      // want `logged value of type User contains sensitive field Password`
+	log.Printf("user %T logged in", u)
+	log.Println("login", u) // want `logged value of type User contains sensitive field Password`
+	_ = fmt.Sprint(u)
+
+	logger := zap.NewExample()
+	logger.Info("login", zap.Object("user", mu))
+	logger.Info("login", zap.Any("user", u)) // want `logged value of type User contains sensitive field Password`
+}
@@ -0,0 +1,46 @@
+package logutil
+
+import (
+	"context"
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+func LogErr(ctx context.Context, msg string, args ...any) { // want LogErr:`logwrapper\(log/slog.ErrorContext, msg=1, args=2\)`
+	slog.ErrorContext(ctx, msg, args...)
+}
+
+func Notice(msg string) { // want Notice:`logwrapper\(log/slog.Info, msg=0, args=-1\)`
+	slog.Info(msg, "component", "notice")
+}
+
+func Failf(code int, format string, args ...interface{}) { // want Failf:`logwrapper\(go.uber.org/zap.Errorf, msg=1, args=2\)`
+	zap.S().Errorf(format, args...)
+}
+
+// Audit forwards to another wrapper declared later in the file.
+func Audit(msg string, args ...any) { // want Audit:`logwrapper\(log/slog.ErrorContext, msg=0, args=1\)`
+	logAudit(msg, args...)
+}
+
+func logAudit(msg string, args ...any) { // want logAudit:`logwrapper\(log/slog.ErrorContext, msg=0, args=1\)`
+	LogErr(context.Background(), msg, args...)
+}
+
+type Service struct{}
+
+func (s *Service) Warn(ctx context.Context, msg string, kv ...any) { // want Warn:`logwrapper\(log/slog.WarnContext, msg=1, args=2\)`
+	slog.WarnContext(ctx, msg, kv...)
+}
+
+// Rewrites modifies the message before logging, so it is not a plain wrapper.
+func Rewrites(msg string) {
+	msg = "[app] " + msg
+	slog.Info(msg)
+}
+
+// Constant logs a fixed message and does not forward a parameter.
+func Constant(name string) {
+	slog.Info("constant", "name", name)
+}
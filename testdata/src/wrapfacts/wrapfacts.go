@@ -0,0 +1,21 @@
+package wrapfacts
+
+import (
+	"context"
+
+	"wrapfacts/logutil"
+)
+
+func Calls(ctx context.Context, password string) {
+	logutil.LogErr(ctx, "Failed to connect")            // want "log message should start with a lowercase letter"
+	logutil.LogErr(ctx, "failed", "password", password) // want "log field key may contain sensitive data" "variable name suggests sensitive data"
+	logutil.LogErr(ctx, "failed", "ключ", 1)            // want "log message should be in English"
+	logutil.Notice("done!")                             // want "log message should not contain special characters or emoji"
+	logutil.Failf(500, "Request failed: %+v", ctx)      // want "log message should start with a lowercase letter"
+	logutil.Failf(500, "request for %s", password)      // want "variable name suggests sensitive data"
+	logutil.Audit("user %s deleted", "id", 1)           // want `log message contains formatting directive "%s" but Audit is not a printf-style method`
+	(&logutil.Service{}).Warn(ctx, "Slow request")      // want "log message should start with a lowercase letter"
+
+	logutil.Rewrites("Not a wrapper") // OK
+	logutil.Constant("Not a message") // OK
+}
@@ -0,0 +1,52 @@
+package wrappercheck
+
+import (
+	"context"
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+// Logger is an application-level logging interface.
+type Logger interface {
+	Info(msg string, args ...any)
+	ErrorContext(ctx context.Context, msg string, args ...any)
+}
+
+// Embedded wraps *slog.Logger and inherits its methods.
+type Embedded struct {
+	*slog.Logger
+}
+
+// Wrapper forwards to slog with its own methods.
+type Wrapper struct {
+	inner *slog.Logger
+}
+
+func (w *Wrapper) Warn(msg string, args ...any) { w.inner.Warn(msg, args...) } // want Warn:`logwrapper\(log/slog.Warn, msg=0, args=1\)`
+
+// FieldLogger mirrors zap's structured API.
+type FieldLogger struct{}
+
+func (FieldLogger) Error(msg string, fields ...zap.Field) {}
+
+// Other has log-like names but different shapes.
+type Other struct{}
+
+func (Other) Info(msg string)              {}
+func (Other) Error(args ...any)            {}
+func (Other) Errorf(f string, args ...any) {}
+
+func Calls(ctx context.Context, l Logger, e Embedded, w *Wrapper, password string) {
+	l.Info("Starting server")                               // want "log message should start with a lowercase letter"
+	l.Info("login", "password", password)                   // want "log field key may contain sensitive data" "variable name suggests sensitive data"
+	l.ErrorContext(ctx, "failed!")                          // want "log message should not contain special characters or emoji"
+	e.Info("Starting server")                               // want "log message should start with a lowercase letter"
+	w.Warn("Disk almost full")                              // want "log message should start with a lowercase letter"
+	FieldLogger{}.Error("Failed", zap.String("token", "x")) // want "log message should start with a lowercase letter" "log field key may contain sensitive data"
+
+	o := Other{}
+	o.Info("Starting server")   // OK (not a logger shape)
+	o.Error("Starting server")  // OK
+	o.Errorf("Starting server") // OK
+}
@@ -0,0 +1,33 @@
+package zerologcheck
+
+import (
+	"errors"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+func Messages(user string) {
+	log.Info().Msg("Started")                       // want "log message should start with a lowercase letter"
+	log.Info().Str("user", user).Msg("started")     // OK
+	log.Error().Err(errors.New("x")).Msg("failed!") // want "log message should not contain special characters or emoji"
+	log.Warn().Msg("запуск")                        // want "log message should be in English"
+	log.Info().Msgf("User %s logged in", user)      // want "log message should start with a lowercase letter"
+	log.Info().Msgf("loaded %+v", user)             // OK
+	log.Info().Msg("user %s logged in")             // want `log message contains formatting directive "%s" but Msg is not a printf-style method`
+	log.Debug().Str("user", user).Send()            // OK
+	log.Print("Starting server")                    // want "log message should start with a lowercase letter"
+	log.Printf("Starting %s", user)                 // want "log message should start with a lowercase letter"
+
+	logger := zerolog.New()
+	logger.Info().Int("attempt", 1).Msg("Retrying") // want "log message should start with a lowercase letter"
+}
+
+func Fields(password, token string) {
+	log.Info().Str("password", "x").Msg("login")         // want "log field key may contain sensitive data"
+	log.Info().Str("user", password).Msg("login")        // want "variable name suggests sensitive data"
+	log.Info().Str("ключ", "x").Msg("login")             // want "log message should be in English"
+	log.Info().Str("key!", "x").Int("n", 1).Msg("login") // want "log message should not contain special characters or emoji"
+	log.Debug().Str("value", token).Send()               // want "variable name suggests sensitive data"
+	log.Info().Msgf("user %s", password)                 // want "variable name suggests sensitive data"
+}